@@ -60,6 +60,31 @@ func getRepoInfo() {
 		config.GitHub.Owner = repo.Owner
 		config.GitHub.Repo = repo.Name
 	}
+
+	branch, err := getCurrentBranch()
+	if err != nil {
+		log.Printf("cannot get current branch: %s", err)
+	}
+	config.GitHub.Branch = branch
+}
+
+func getCurrentBranch() (string, error) {
+	if _, err := exec.LookPath("git"); err != nil {
+		return "", err
+	}
+	cmd := exec.Command("git", "rev-parse", "--abbrev-ref", "HEAD")
+	out, err := cmd.CombinedOutput()
+
+	result := strings.TrimRight(string(out), "\r\n")
+	if err != nil {
+		return "", fmt.Errorf("%s: %s", err, result)
+	}
+
+	// detached HEAD
+	if result == "HEAD" {
+		return "", nil
+	}
+	return result, nil
 }
 
 func getOwnerRepo() (*Repo, error) {
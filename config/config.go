@@ -10,9 +10,10 @@ import (
 )
 
 type github struct {
-	Owner string
-	Repo  string
-	Token string `yaml:"token"`
+	Owner  string
+	Repo   string
+	Branch string
+	Token  string `yaml:"token"`
 }
 
 type app struct {
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"github.com/gdamore/tcell/v2"
+)
+
+// ActionsSetting represents an Actions secret or variable of a repository or
+// of one of its environments. The value of a secret is never known.
+type ActionsSetting struct {
+	Secret      bool
+	Name        string
+	Value       string
+	Environment string // empty for the repository
+	UpdatedAt   string
+}
+
+func (s *ActionsSetting) Key() string {
+	return s.Kind() + "/" + s.Environment + "/" + s.Name
+}
+
+// Kind is "secret" or "variable".
+func (s *ActionsSetting) Kind() string {
+	if s.Secret {
+		return "secret"
+	}
+	return "variable"
+}
+
+// Scope is the environment of the setting, or "repository".
+func (s *ActionsSetting) Scope() string {
+	if s.Environment == "" {
+		return "repository"
+	}
+	return s.Environment
+}
+
+func (s *ActionsSetting) Fields() []Field {
+	kindColor := tcell.ColorGreen
+	if s.Secret {
+		kindColor = tcell.ColorRed
+	}
+
+	return []Field{
+		{Text: s.Kind(), Color: kindColor},
+		{Text: s.Scope(), Color: tcell.ColorOrange},
+		{Text: s.Name, Color: tcell.ColorWhite},
+		{Text: s.Value, Color: tcell.ColorYellow},
+		{Text: s.UpdatedAt, Color: tcell.ColorGray},
+	}
+}
@@ -0,0 +1,34 @@
+package domain
+
+// WorkflowStats aggregates the runs of a workflow.
+type WorkflowStats struct {
+	Workflow    string
+	Runs        int
+	Successes   int
+	Failures    int
+	SuccessRate float64 // successes of the runs that succeeded or failed, 0 to 1
+	Median      string
+	P95         string
+
+	// Durations are the durations of the runs that succeeded or failed in
+	// seconds, oldest first.
+	Durations []float64
+}
+
+// FailureStreak is a series of failed runs of a workflow on a branch that
+// continues up to the newest completed run.
+type FailureStreak struct {
+	Workflow string
+	Branch   string
+	Failures int
+	Since    string
+}
+
+// JobStats aggregates the durations of a job over several runs.
+type JobStats struct {
+	Workflow string
+	Name     string
+	Runs     int
+	Median   string
+	Max      string
+}
@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// Annotation is an error, warning or notice reported by a workflow job, either
+// as a workflow command in its log or as an annotation of its check run.
+type Annotation struct {
+	// Level is one of "error", "warning" or "notice".
+	Level   string
+	Path    string
+	Line    int
+	Title   string
+	Message string
+	// LogLine is the index of the log line the annotation was found on,
+	// or -1 for annotations of the check run.
+	LogLine int
+}
+
+func (a *Annotation) Key() string {
+	return fmt.Sprintf("%s:%d:%d:%s", a.Path, a.Line, a.LogLine, a.Message)
+}
+
+// Location returns "path:line", "path" or "" depending on what is known.
+func (a *Annotation) Location() string {
+	if a.Path == "" {
+		return ""
+	}
+	if a.Line > 0 {
+		return fmt.Sprintf("%s:%d", a.Path, a.Line)
+	}
+	return a.Path
+}
+
+func (a *Annotation) Fields() []Field {
+	levelColor := tcell.ColorGray
+	switch a.Level {
+	case "error":
+		levelColor = tcell.ColorRed
+	case "warning":
+		levelColor = tcell.ColorYellow
+	}
+
+	message := a.Message
+	if i := strings.Index(message, "\n"); i >= 0 {
+		message = message[:i]
+	}
+
+	return []Field{
+		{Text: a.Level, Color: levelColor},
+		{Text: a.Location(), Color: tcell.ColorBlue},
+		{Text: message, Color: tcell.ColorWhite},
+	}
+}
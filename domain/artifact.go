@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"fmt"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// Artifact represents a file uploaded by a GitHub Actions workflow run.
+type Artifact struct {
+	ID        int64
+	Name      string
+	Size      string
+	ExpiresAt string
+	Expired   bool
+}
+
+func (a *Artifact) Key() string {
+	return fmt.Sprintf("%d", a.ID)
+}
+
+func (a *Artifact) Fields() []Field {
+	expires, color := a.ExpiresAt, tcell.ColorWhite
+	if a.Expired {
+		expires, color = "expired", tcell.ColorGray
+	}
+
+	return []Field{
+		{Text: a.Name, Color: tcell.ColorWhite},
+		{Text: a.Size, Color: tcell.ColorYellow},
+		{Text: expires, Color: color},
+	}
+}
+
+// ArtifactFile represents a file in the zip archive of an artifact.
+type ArtifactFile struct {
+	Name string
+	Size string
+}
+
+func (f *ArtifactFile) Key() string {
+	return f.Name
+}
+
+func (f *ArtifactFile) Fields() []Field {
+	return []Field{
+		{Text: f.Name, Color: tcell.ColorWhite},
+		{Text: f.Size, Color: tcell.ColorYellow},
+	}
+}
@@ -3,6 +3,7 @@ package domain
 import "github.com/gdamore/tcell/v2"
 
 type AssignableUser struct {
+	ID    string
 	Login string
 }
 
@@ -22,3 +22,13 @@ func (c *Comment) Fields() []Field {
 
 	return f
 }
+
+// CommentsPage is where the loaded comments of an issue are in all its
+// comments.
+type CommentsPage struct {
+	TotalCount  int
+	StartCursor string
+	EndCursor   string
+	HasPrevious bool
+	HasNext     bool
+}
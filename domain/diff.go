@@ -0,0 +1,21 @@
+package domain
+
+// DiffLineKind classifies a line of a unified diff.
+type DiffLineKind int
+
+const (
+	DiffLineContext DiffLineKind = iota
+	DiffLineAdded
+	DiffLineDeleted
+	DiffLineHunk
+)
+
+// DiffLine is a single line of a unified diff patch. OldLine and NewLine are
+// the line numbers in the base and head file; they are 0 when the line does
+// not exist on that side.
+type DiffLine struct {
+	Kind    DiffLineKind
+	Text    string
+	OldLine int
+	NewLine int
+}
@@ -6,4 +6,6 @@ var (
 	ErrCommentBodyIsEmpty = errors.New("comment body is empty")
 	ErrNotFoundComment    = errors.New("not found comment")
 	ErrNotFoundIssue      = errors.New("not found issue")
+	ErrReviewBodyIsEmpty  = errors.New("review body is empty")
+	ErrNotCommentableLine = errors.New("cannot comment on this line")
 )
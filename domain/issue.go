@@ -7,20 +7,22 @@ import (
 )
 
 type Issue struct {
-	ID        string
-	Repo      string
-	RepoOwner string
-	Number    string
-	State     string
-	Title     string
-	Body      string
-	Author    string
-	URL       string
-	Labels    []Item
-	Assignees []Item
-	Comments  []Item
-	MileStone []Item
-	Projects  []Item
+	ID           string
+	Repo         string
+	RepoOwner    string
+	Number       string
+	State        string
+	StateReason  string
+	Title        string
+	Body         string
+	Author       string
+	URL          string
+	Labels       []Item
+	Assignees    []Item
+	Comments     []Item
+	CommentsPage CommentsPage
+	MileStone    []Item
+	Projects     []Item
 }
 
 func (i *Issue) Key() string {
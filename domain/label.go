@@ -3,6 +3,7 @@ package domain
 import "github.com/gdamore/tcell/v2"
 
 type Label struct {
+	ID          string
 	Name        string
 	Description string
 }
@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// PendingDeployment represents a deployment of a workflow run that waits for
+// the review of a protected environment.
+type PendingDeployment struct {
+	EnvironmentID int64
+	Environment   string
+	Reviewers     []string
+	WaitTimer     string
+	CanApprove    bool
+}
+
+func (d *PendingDeployment) Key() string {
+	return fmt.Sprintf("%d", d.EnvironmentID)
+}
+
+func (d *PendingDeployment) Fields() []Field {
+	approve, color := "no", tcell.ColorGray
+	if d.CanApprove {
+		approve, color = "yes", tcell.ColorGreen
+	}
+
+	return []Field{
+		{Text: d.Environment, Color: tcell.ColorOrange},
+		{Text: strings.Join(d.Reviewers, ", "), Color: tcell.ColorWhite},
+		{Text: d.WaitTimer, Color: tcell.ColorYellow},
+		{Text: approve, Color: color},
+	}
+}
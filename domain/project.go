@@ -3,6 +3,7 @@ package domain
 import "github.com/gdamore/tcell/v2"
 
 type Project struct {
+	ID   string
 	Name string
 	URL  string
 }
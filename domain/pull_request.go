@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"fmt"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+type PullRequest struct {
+	ID             string
+	Repo           string
+	RepoOwner      string
+	Number         string
+	State          string
+	Title          string
+	Body           string
+	Author         string
+	URL            string
+	BaseRef        string
+	HeadRef        string
+	IsDraft        bool
+	ReviewDecision string
+	Mergeable      string
+	Labels         []Item
+	Assignees      []Item
+}
+
+func (p *PullRequest) Key() string {
+	return p.ID
+}
+
+func (p *PullRequest) Fields() []Field {
+	stateColor := tcell.ColorGreen
+	switch p.State {
+	case "CLOSED":
+		stateColor = tcell.ColorRed
+	case "MERGED":
+		stateColor = tcell.ColorFuchsia
+	}
+
+	var draft string
+	if p.IsDraft {
+		draft = "draft"
+	}
+
+	reviewColor := tcell.ColorGray
+	switch p.ReviewDecision {
+	case "APPROVED":
+		reviewColor = tcell.ColorGreen
+	case "CHANGES_REQUESTED":
+		reviewColor = tcell.ColorRed
+	case "REVIEW_REQUIRED":
+		reviewColor = tcell.ColorYellow
+	}
+
+	mergeableColor := tcell.ColorGray
+	switch p.Mergeable {
+	case "MERGEABLE":
+		mergeableColor = tcell.ColorGreen
+	case "CONFLICTING":
+		mergeableColor = tcell.ColorRed
+	}
+
+	f := []Field{
+		{Text: p.Number, Color: tcell.ColorBlue},
+		{Text: p.State, Color: stateColor},
+		{Text: p.Author, Color: tcell.ColorYellow},
+		{Text: fmt.Sprintf("%s <- %s", p.BaseRef, p.HeadRef), Color: tcell.ColorLightSalmon},
+		{Text: draft, Color: tcell.ColorGray},
+		{Text: p.ReviewDecision, Color: reviewColor},
+		{Text: p.Mergeable, Color: mergeableColor},
+		{Text: p.Title, Color: tcell.ColorWhite},
+	}
+
+	return f
+}
@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"fmt"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// PullRequestFile represents a file changed in a pull request.
+type PullRequestFile struct {
+	Filename  string
+	Status    string
+	Additions int
+	Deletions int
+	Patch     string
+	BlobURL   string
+}
+
+func (f *PullRequestFile) Key() string {
+	return f.Filename
+}
+
+func (f *PullRequestFile) Fields() []Field {
+	return []Field{
+		{Text: f.Status, Color: tcell.ColorLightSalmon},
+		{Text: fmt.Sprintf("+%d", f.Additions), Color: tcell.ColorGreen},
+		{Text: fmt.Sprintf("-%d", f.Deletions), Color: tcell.ColorRed},
+		{Text: f.Filename, Color: tcell.ColorWhite},
+	}
+}
@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// ReviewComment is a line comment of a pull request review that has not been submitted yet.
+type ReviewComment struct {
+	Path string
+	Line int
+	Side string // "LEFT" for the base file, "RIGHT" for the head file
+	Body string
+}
+
+func (r *ReviewComment) Key() string {
+	return fmt.Sprintf("%s:%s:%d", r.Path, r.Side, r.Line)
+}
+
+func (r *ReviewComment) Fields() []Field {
+	body := strings.SplitN(r.Body, "\n", 2)[0]
+	return []Field{
+		{Text: fmt.Sprintf("%s:%d", r.Path, r.Line), Color: tcell.ColorLightSalmon},
+		{Text: body, Color: tcell.ColorWhite},
+	}
+}
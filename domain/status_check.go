@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"github.com/gdamore/tcell/v2"
+)
+
+// StatusCheck is a check run or a commit status reported on a commit.
+type StatusCheck struct {
+	// ID is the node ID of the check run or the commit status.
+	ID         string
+	Name       string
+	State      string
+	IsRequired bool
+	Duration   string
+	URL        string
+
+	// RunID, RunName and JobID are set when the check is a GitHub Actions job.
+	RunID   int64
+	RunName string
+	JobID   int64
+}
+
+func (s *StatusCheck) Key() string {
+	return s.ID
+}
+
+// IsActions reports whether the check is a GitHub Actions job.
+func (s *StatusCheck) IsActions() bool {
+	return s.RunID > 0 && s.JobID > 0
+}
+
+// IsFailing reports whether the check finished without succeeding.
+func (s *StatusCheck) IsFailing() bool {
+	switch s.State {
+	case "FAILURE", "ERROR", "TIMED_OUT", "CANCELLED", "ACTION_REQUIRED", "STARTUP_FAILURE":
+		return true
+	}
+	return false
+}
+
+// IsPending reports whether the check has not finished yet.
+func (s *StatusCheck) IsPending() bool {
+	switch s.State {
+	case "QUEUED", "IN_PROGRESS", "WAITING", "PENDING", "REQUESTED", "EXPECTED":
+		return true
+	}
+	return false
+}
+
+func (s *StatusCheck) Fields() []Field {
+	stateColor := tcell.ColorGray
+	switch {
+	case s.State == "SUCCESS":
+		stateColor = tcell.ColorGreen
+	case s.IsFailing():
+		stateColor = tcell.ColorRed
+	case s.IsPending():
+		stateColor = tcell.ColorYellow
+	}
+
+	var required string
+	if s.IsRequired {
+		required = "required"
+	}
+
+	return []Field{
+		{Text: s.State, Color: stateColor},
+		{Text: s.Name, Color: tcell.ColorWhite},
+		{Text: required, Color: tcell.ColorYellow},
+		{Text: s.Duration, Color: tcell.ColorWhite},
+	}
+}
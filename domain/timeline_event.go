@@ -0,0 +1,44 @@
+package domain
+
+import "github.com/gdamore/tcell/v2"
+
+// TimelineEvent is a comment or an event in the timeline of an issue.
+type TimelineEvent struct {
+	ID        string
+	Event     string
+	Actor     string
+	CreatedAt string
+	Summary   string
+	// Body is the text of a comment, or the details of other events.
+	Body string
+	URL  string
+}
+
+func (t *TimelineEvent) Key() string {
+	return t.ID
+}
+
+func (t *TimelineEvent) Fields() []Field {
+	return []Field{
+		{Text: t.CreatedAt, Color: tcell.ColorWhite},
+		{Text: t.Actor, Color: tcell.ColorYellow},
+		{Text: t.Event, Color: t.eventColor()},
+		{Text: t.Summary, Color: tcell.ColorWhite},
+	}
+}
+
+func (t *TimelineEvent) eventColor() tcell.Color {
+	switch t.Event {
+	case "commented":
+		return tcell.ColorLightSkyBlue
+	case "closed":
+		return tcell.ColorRed
+	case "reopened":
+		return tcell.ColorGreen
+	case "labeled", "unlabeled":
+		return tcell.ColorLightYellow
+	case "referenced", "connected", "commit":
+		return tcell.ColorPurple
+	}
+	return tcell.ColorLightSalmon
+}
@@ -0,0 +1,37 @@
+package domain
+
+import "fmt"
+
+// WorkflowDefinition is the outline of a workflow file: the events that
+// trigger it, its jobs and the problems found in it.
+type WorkflowDefinition struct {
+	Triggers []string
+	Jobs     []*WorkflowDefinitionJob
+	Warnings []*WorkflowWarning
+}
+
+// WorkflowDefinitionJob is a job declared in a workflow file.
+type WorkflowDefinitionJob struct {
+	ID     string
+	Name   string
+	RunsOn string
+	// Uses is the reusable workflow the job calls, if any.
+	Uses  string
+	Needs []string
+	// Line is the 1-based line the job is declared on, or 0 if unknown.
+	Line int
+}
+
+// WorkflowWarning is a problem found in a workflow file.
+type WorkflowWarning struct {
+	// Line is the 1-based line the problem was found on, or 0 if unknown.
+	Line    int
+	Message string
+}
+
+func (w *WorkflowWarning) String() string {
+	if w.Line == 0 {
+		return w.Message
+	}
+	return fmt.Sprintf("line %d: %s", w.Line, w.Message)
+}
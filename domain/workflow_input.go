@@ -0,0 +1,12 @@
+package domain
+
+// WorkflowInput is an input of a workflow_dispatch trigger.
+type WorkflowInput struct {
+	Name        string
+	Description string
+	// Type is one of "string", "choice", "boolean", "number" or "environment".
+	Type     string
+	Default  string
+	Required bool
+	Options  []string
+}
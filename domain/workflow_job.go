@@ -15,6 +15,8 @@ type WorkflowJob struct {
 	Duration   string
 	HTMLURL    string
 	RunID      int64
+	HeadSHA    string
+	Steps      []*WorkflowStep
 }
 
 func (j *WorkflowJob) Key() string {
@@ -16,6 +16,7 @@ type WorkflowRun struct {
 	HeadBranch string
 	Event      string
 	RunNumber  int
+	Attempt    int
 	CreatedAt  string
 	Duration   string
 	HTMLURL    string
@@ -29,9 +30,14 @@ func (w *WorkflowRun) Key() string {
 func (w *WorkflowRun) Fields() []Field {
 	statusText, color := statusDisplay(w.Status, w.Conclusion)
 
+	name := w.Name
+	if w.Attempt > 1 {
+		name = fmt.Sprintf("%s (attempt %d)", name, w.Attempt)
+	}
+
 	return []Field{
 		{Text: statusText, Color: color},
-		{Text: w.Name, Color: tcell.ColorWhite},
+		{Text: name, Color: tcell.ColorWhite},
 		{Text: w.HeadBranch, Color: tcell.ColorBlue},
 		{Text: w.Event, Color: tcell.ColorYellow},
 		{Text: w.Duration, Color: tcell.ColorWhite},
@@ -54,8 +60,11 @@ func statusDisplay(status, conclusion string) (string, tcell.Color) {
 		}
 	case "in_progress":
 		return status, tcell.ColorYellow
+	case "waiting":
+		// waiting for the review of a protected environment
+		return status, tcell.ColorOrange
 	default:
-		// queued, waiting, etc.
+		// queued, requested, pending, etc.
 		return status, tcell.ColorGray
 	}
 }
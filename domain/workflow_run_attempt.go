@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"fmt"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// WorkflowRunAttempt represents one attempt of a GitHub Actions workflow run.
+// Every re-run of a run is a new attempt.
+type WorkflowRunAttempt struct {
+	RunID      int64
+	Attempt    int
+	Status     string
+	Conclusion string
+	Actor      string
+	StartedAt  string
+	Duration   string
+	HTMLURL    string
+}
+
+func (a *WorkflowRunAttempt) Key() string {
+	return fmt.Sprintf("%d/%d", a.RunID, a.Attempt)
+}
+
+func (a *WorkflowRunAttempt) Fields() []Field {
+	statusText, color := statusDisplay(a.Status, a.Conclusion)
+
+	return []Field{
+		{Text: fmt.Sprintf("#%d", a.Attempt), Color: tcell.ColorWhite},
+		{Text: statusText, Color: color},
+		{Text: a.Actor, Color: tcell.ColorBlue},
+		{Text: a.StartedAt, Color: tcell.ColorWhite},
+		{Text: a.Duration, Color: tcell.ColorWhite},
+	}
+}
+
+// JobComparison is a job of a workflow run in two of its attempts. Before or
+// After is nil if the job did not run in that attempt.
+type JobComparison struct {
+	Name string
+	// Occurrence counts the jobs with the same name before this one.
+	Occurrence int
+	Before     *WorkflowJob
+	After      *WorkflowJob
+}
+
+func (c *JobComparison) Key() string {
+	return fmt.Sprintf("%s#%d", c.Name, c.Occurrence)
+}
+
+// Changed reports whether the job ended differently in the two attempts.
+func (c *JobComparison) Changed() bool {
+	return jobResult(c.Before) != jobResult(c.After)
+}
+
+func (c *JobComparison) Fields() []Field {
+	name, nameColor := c.Name, tcell.ColorWhite
+	if c.Changed() {
+		name, nameColor = "* "+c.Name, tcell.ColorOrange
+	}
+
+	before, beforeColor := jobStatusDisplay(c.Before)
+	after, afterColor := jobStatusDisplay(c.After)
+
+	return []Field{
+		{Text: name, Color: nameColor},
+		{Text: before, Color: beforeColor},
+		{Text: after, Color: afterColor},
+	}
+}
+
+func jobResult(job *WorkflowJob) string {
+	if job == nil {
+		return ""
+	}
+	return job.Status + "/" + job.Conclusion
+}
+
+func jobStatusDisplay(job *WorkflowJob) (string, tcell.Color) {
+	if job == nil {
+		return "-", tcell.ColorGray
+	}
+	return statusDisplay(job.Status, job.Conclusion)
+}
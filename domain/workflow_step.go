@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// WorkflowStep represents a step of a GitHub Actions workflow job.
+type WorkflowStep struct {
+	Number      int64
+	Name        string
+	Status      string
+	Conclusion  string
+	Duration    string
+	StartedAt   time.Time
+	CompletedAt time.Time
+}
+
+func (s *WorkflowStep) Key() string {
+	return fmt.Sprintf("%d", s.Number)
+}
+
+func (s *WorkflowStep) Fields() []Field {
+	statusText, color := statusDisplay(s.Status, s.Conclusion)
+
+	return []Field{
+		{Text: statusText, Color: color},
+		{Text: s.Name, Color: tcell.ColorWhite},
+		{Text: s.Duration, Color: tcell.ColorWhite},
+	}
+}
+
+// LogSection is a part of a job log. Lines between ##[group] and ##[endgroup]
+// markers form a group that can be collapsed to its title.
+type LogSection struct {
+	Title     string
+	Lines     []string
+	IsGroup   bool
+	Collapsed bool
+}
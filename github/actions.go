@@ -2,6 +2,7 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -49,6 +50,7 @@ func ConvertWorkflowRun(run *gogithub.WorkflowRun) *domain.WorkflowRun {
 		HeadBranch: run.GetHeadBranch(),
 		Event:      run.GetEvent(),
 		RunNumber:  run.GetRunNumber(),
+		Attempt:    run.GetRunAttempt(),
 		Duration:   dur,
 		CreatedAt:  formatTime(run.GetCreatedAt().Time),
 		HTMLURL:    run.GetHTMLURL(),
@@ -63,6 +65,11 @@ func ConvertWorkflowJob(job *gogithub.WorkflowJob) *domain.WorkflowJob {
 		dur = formatDuration(d)
 	}
 
+	steps := make([]*domain.WorkflowStep, len(job.Steps))
+	for i, step := range job.Steps {
+		steps[i] = ConvertWorkflowStep(step)
+	}
+
 	return &domain.WorkflowJob{
 		ID:         job.GetID(),
 		Name:       job.GetName(),
@@ -71,9 +78,31 @@ func ConvertWorkflowJob(job *gogithub.WorkflowJob) *domain.WorkflowJob {
 		Duration:   dur,
 		HTMLURL:    job.GetHTMLURL(),
 		RunID:      job.GetRunID(),
+		HeadSHA:    job.GetHeadSHA(),
+		Steps:      steps,
 	}
 }
 
+// ConvertWorkflowStep converts a go-github TaskStep to a domain WorkflowStep.
+func ConvertWorkflowStep(step *gogithub.TaskStep) *domain.WorkflowStep {
+	s := &domain.WorkflowStep{
+		Number:     step.GetNumber(),
+		Name:       step.GetName(),
+		Status:     step.GetStatus(),
+		Conclusion: step.GetConclusion(),
+	}
+	if step.StartedAt != nil {
+		s.StartedAt = step.StartedAt.Time
+	}
+	if step.CompletedAt != nil {
+		s.CompletedAt = step.CompletedAt.Time
+	}
+	if !s.StartedAt.IsZero() && !s.CompletedAt.IsZero() {
+		s.Duration = formatDuration(s.CompletedAt.Sub(s.StartedAt))
+	}
+	return s
+}
+
 // formatDuration formats a time.Duration as a human-readable string:
 // <60s -> "Xs", <1h -> "Xm Ys", >=1h -> "Xh Ym".
 func formatDuration(d time.Duration) string {
@@ -167,11 +196,46 @@ func ListWorkflowJobs(ctx context.Context, owner, repo string, runID int64, opts
 	return jobs, nil
 }
 
-// GetWorkflowJobLog fetches the log content for a specific workflow job.
-// It follows the redirect URL returned by the API and downloads the log content,
-// capped at maxLogSize (10MB) to prevent OOM. Returns the cleaned log content
-// and whether the log was truncated due to the size limit.
-func GetWorkflowJobLog(ctx context.Context, owner, repo string, jobID int64) (string, bool, error) {
+// getWorkflowJobLogBody opens the log of a workflow job for reading. The
+// caller must close it.
+func getWorkflowJobLogBody(ctx context.Context, owner, repo string, jobID int64) (io.ReadCloser, error) {
+	client := GetRESTClient()
+	if client == nil {
+		return nil, fmt.Errorf("REST client not initialized")
+	}
+
+	logURL, _, err := client.Actions.GetWorkflowJobLogs(ctx, owner, repo, jobID, 4)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get log URL for job %d: %w", jobID, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, logURL.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create log request: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download log: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("log download returned status %d", resp.StatusCode)
+	}
+	return resp.Body, nil
+}
+
+// GetWorkflowJobLogFrom downloads the raw log of a workflow job from the byte
+// offset on, so that a growing log can be followed without downloading it
+// again. It returns an empty string if nothing was added after offset. The
+// followed log is held in memory, so it is capped at maxLogSize, and whether
+// the log was truncated is returned.
+func GetWorkflowJobLogFrom(ctx context.Context, owner, repo string, jobID, offset int64) (string, bool, error) {
+	if offset >= maxLogSize {
+		return "", true, nil
+	}
+
 	client := GetRESTClient()
 	if client == nil {
 		return "", false, fmt.Errorf("REST client not initialized")
@@ -182,26 +246,238 @@ func GetWorkflowJobLog(ctx context.Context, owner, repo string, jobID int64) (st
 		return "", false, fmt.Errorf("failed to get log URL for job %d: %w", jobID, err)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, logURL.String(), nil)
+	data, err := downloadLogFrom(ctx, logURL.String(), offset)
+	if err != nil {
+		return "", false, err
+	}
+	return data, offset+int64(len(data)) >= maxLogSize, nil
+}
+
+// downloadLogFrom requests the log at url from the byte offset on, up to
+// maxLogSize bytes from its start. Servers that ignore the range send the
+// whole log, whose first offset bytes are skipped.
+func downloadLogFrom(ctx context.Context, url string, offset int64) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
-		return "", false, fmt.Errorf("failed to create log request: %w", err)
+		return "", fmt.Errorf("failed to create log request: %w", err)
+	}
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
 	}
 
 	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
-		return "", false, fmt.Errorf("failed to download log: %w", err)
+		return "", fmt.Errorf("failed to download log: %w", err)
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return "", false, fmt.Errorf("log download returned status %d", resp.StatusCode)
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+	case http.StatusRequestedRangeNotSatisfiable:
+		return "", nil
+	case http.StatusOK:
+		if offset > 0 {
+			if _, err := io.CopyN(io.Discard, resp.Body, offset); err != nil {
+				if errors.Is(err, io.EOF) {
+					return "", nil
+				}
+				return "", fmt.Errorf("failed to read log body: %w", err)
+			}
+		}
+	default:
+		return "", fmt.Errorf("log download returned status %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxLogSize-offset))
+	if err != nil {
+		return "", fmt.Errorf("failed to read log body: %w", err)
+	}
+	return string(data), nil
+}
+
+// RerunWorkflowRun re-runs all jobs of a workflow run.
+func RerunWorkflowRun(ctx context.Context, owner, repo string, runID int64) error {
+	client := GetRESTClient()
+	if client == nil {
+		return fmt.Errorf("REST client not initialized")
+	}
+
+	if _, err := client.Actions.RerunWorkflowByID(ctx, owner, repo, runID); !isAccepted(err) {
+		return fmt.Errorf("failed to re-run workflow run %d: %w", runID, err)
+	}
+	return nil
+}
+
+// RerunFailedJobs re-runs the failed jobs of a workflow run and the jobs depending on them.
+func RerunFailedJobs(ctx context.Context, owner, repo string, runID int64) error {
+	client := GetRESTClient()
+	if client == nil {
+		return fmt.Errorf("REST client not initialized")
+	}
+
+	if _, err := client.Actions.RerunFailedJobsByID(ctx, owner, repo, runID); !isAccepted(err) {
+		return fmt.Errorf("failed to re-run failed jobs of workflow run %d: %w", runID, err)
+	}
+	return nil
+}
+
+// RerunWorkflowJob re-runs a single job and the jobs depending on it.
+func RerunWorkflowJob(ctx context.Context, owner, repo string, jobID int64) error {
+	client := GetRESTClient()
+	if client == nil {
+		return fmt.Errorf("REST client not initialized")
+	}
+
+	if _, err := client.Actions.RerunJobByID(ctx, owner, repo, jobID); !isAccepted(err) {
+		return fmt.Errorf("failed to re-run job %d: %w", jobID, err)
+	}
+	return nil
+}
+
+// CancelWorkflowRun cancels an in-progress workflow run.
+func CancelWorkflowRun(ctx context.Context, owner, repo string, runID int64) error {
+	client := GetRESTClient()
+	if client == nil {
+		return fmt.Errorf("REST client not initialized")
+	}
+
+	if _, err := client.Actions.CancelWorkflowRunByID(ctx, owner, repo, runID); !isAccepted(err) {
+		return fmt.Errorf("failed to cancel workflow run %d: %w", runID, err)
+	}
+	return nil
+}
+
+// isAccepted reports whether a request succeeded. GitHub answers some
+// requests with 202 Accepted, which go-github returns as an AcceptedError.
+func isAccepted(err error) bool {
+	var accepted *gogithub.AcceptedError
+	return err == nil || errors.As(err, &accepted)
+}
+
+// GetWorkflowFile fetches the content of a workflow file at the given ref.
+// An empty ref means the default branch.
+func GetWorkflowFile(ctx context.Context, owner, repo, path, ref string) (string, error) {
+	client := GetRESTClient()
+	if client == nil {
+		return "", fmt.Errorf("REST client not initialized")
+	}
+
+	opts := &gogithub.RepositoryContentGetOptions{Ref: ref}
+	file, _, _, err := client.Repositories.GetContents(ctx, owner, repo, path, opts)
+	if err != nil {
+		return "", fmt.Errorf("failed to get workflow file %s: %w", path, err)
+	}
+	if file == nil {
+		return "", fmt.Errorf("%s is not a file", path)
+	}
+
+	content, err := file.GetContent()
+	if err != nil {
+		return "", fmt.Errorf("failed to decode workflow file %s: %w", path, err)
+	}
+	return content, nil
+}
+
+// DispatchWorkflow triggers a workflow_dispatch event for a workflow on the given ref.
+func DispatchWorkflow(ctx context.Context, owner, repo string, workflowID int64, ref string, inputs map[string]interface{}) error {
+	client := GetRESTClient()
+	if client == nil {
+		return fmt.Errorf("REST client not initialized")
+	}
+
+	event := gogithub.CreateWorkflowDispatchEventRequest{
+		Ref:    ref,
+		Inputs: inputs,
+	}
+	if _, err := client.Actions.CreateWorkflowDispatchEventByID(ctx, owner, repo, workflowID, event); err != nil {
+		return fmt.Errorf("failed to dispatch workflow %d: %w", workflowID, err)
+	}
+	return nil
+}
+
+// ListEnvironments lists all deployment environments of a repository with full pagination.
+func ListEnvironments(ctx context.Context, owner, repo string) ([]*gogithub.Environment, error) {
+	client := GetRESTClient()
+	if client == nil {
+		return nil, fmt.Errorf("REST client not initialized")
+	}
+
+	var allEnvironments []*gogithub.Environment
+	opts := &gogithub.EnvironmentListOptions{ListOptions: gogithub.ListOptions{PerPage: 100}}
+
+	for {
+		envs, resp, err := client.Repositories.ListEnvironments(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list environments: %w", err)
+		}
+		allEnvironments = append(allEnvironments, envs.Environments...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return allEnvironments, nil
+}
+
+// GetWorkflowJob fetches a workflow job with its current status and steps.
+func GetWorkflowJob(ctx context.Context, owner, repo string, jobID int64) (*gogithub.WorkflowJob, error) {
+	client := GetRESTClient()
+	if client == nil {
+		return nil, fmt.Errorf("REST client not initialized")
 	}
 
-	body, err := io.ReadAll(io.LimitReader(resp.Body, maxLogSize))
+	job, _, err := client.Actions.GetWorkflowJobByID(ctx, owner, repo, jobID)
 	if err != nil {
-		return "", false, fmt.Errorf("failed to read log body: %w", err)
+		return nil, fmt.Errorf("failed to get job %d: %w", jobID, err)
 	}
+	return job, nil
+}
 
-	truncated := int64(len(body)) >= maxLogSize
-	return CleanLog(string(body)), truncated, nil
+// ListCheckRunAnnotations lists the annotations of a check run. The check run
+// of a GitHub Actions job has the same ID as the job.
+func ListCheckRunAnnotations(ctx context.Context, owner, repo string, checkRunID int64) ([]*domain.Annotation, error) {
+	client := GetRESTClient()
+	if client == nil {
+		return nil, fmt.Errorf("REST client not initialized")
+	}
+
+	var annotations []*domain.Annotation
+	opts := &gogithub.ListOptions{PerPage: 100}
+
+	for {
+		page, resp, err := client.Checks.ListCheckRunAnnotations(ctx, owner, repo, checkRunID, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list annotations of check run %d: %w", checkRunID, err)
+		}
+		for _, a := range page {
+			annotations = append(annotations, ConvertCheckRunAnnotation(a))
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return annotations, nil
+}
+
+// ConvertCheckRunAnnotation converts a go-github CheckRunAnnotation to a domain Annotation.
+func ConvertCheckRunAnnotation(a *gogithub.CheckRunAnnotation) *domain.Annotation {
+	// check runs call errors failures
+	level := a.GetAnnotationLevel()
+	if level == "failure" {
+		level = "error"
+	}
+
+	return &domain.Annotation{
+		Level:   level,
+		Path:    a.GetPath(),
+		Line:    a.GetStartLine(),
+		Title:   a.GetTitle(),
+		Message: a.GetMessage(),
+		LogLine: -1,
+	}
 }
@@ -1,6 +1,12 @@
 package github
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -248,6 +254,7 @@ func TestConvertWorkflowJob(t *testing.T) {
 		wantName   string
 		wantStatus string
 		wantConc   string
+		wantSteps  int
 	}{
 		{
 			name: "completed success job",
@@ -260,10 +267,21 @@ func TestConvertWorkflowJob(t *testing.T) {
 				HTMLURL:     gogithub.Ptr("https://github.com/org/repo/actions/runs/12345/jobs/99001"),
 				StartedAt:   &startedAt,
 				CompletedAt: &completedAt,
+				Steps: []*gogithub.TaskStep{
+					{
+						Number:      gogithub.Ptr(int64(1)),
+						Name:        gogithub.Ptr("Set up job"),
+						Status:      gogithub.Ptr("completed"),
+						Conclusion:  gogithub.Ptr("success"),
+						StartedAt:   &startedAt,
+						CompletedAt: &completedAt,
+					},
+				},
 			},
 			wantName:   "build",
 			wantStatus: "completed",
 			wantConc:   "success",
+			wantSteps:  1,
 		},
 		{
 			name: "in_progress job",
@@ -302,6 +320,14 @@ func TestConvertWorkflowJob(t *testing.T) {
 			if got.RunID != tt.job.GetRunID() {
 				t.Errorf("RunID = %d, want %d", got.RunID, tt.job.GetRunID())
 			}
+			if len(got.Steps) != tt.wantSteps {
+				t.Fatalf("len(Steps) = %d, want %d", len(got.Steps), tt.wantSteps)
+			}
+			for _, step := range got.Steps {
+				if step.Duration != "2m 0s" {
+					t.Errorf("step Duration = %q, want %q", step.Duration, "2m 0s")
+				}
+			}
 		})
 	}
 }
@@ -359,12 +385,12 @@ func TestWorkflowRunFields(t *testing.T) {
 			wantStatus: "queued",
 		},
 		{
-			name: "waiting is gray",
+			name: "waiting is orange",
 			run: domain.WorkflowRun{
 				ID: 6, Status: "waiting", Conclusion: "",
 				Name: "CI", HeadBranch: "main", Event: "push", Duration: "",
 			},
-			wantColor:  tcell.ColorGray,
+			wantColor:  tcell.ColorOrange,
 			wantStatus: "waiting",
 		},
 	}
@@ -444,3 +470,62 @@ func TestWorkflowJobFields(t *testing.T) {
 		})
 	}
 }
+
+func TestIsAccepted(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"no error", nil, true},
+		{"202 accepted", &gogithub.AcceptedError{}, true},
+		{"wrapped 202 accepted", fmt.Errorf("wrapped: %w", &gogithub.AcceptedError{}), true},
+		{"other error", errors.New("409 conflict"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isAccepted(tt.err); got != tt.want {
+				t.Errorf("isAccepted(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDownloadLogFrom(t *testing.T) {
+	const content = "line 1\nline 2\nline 3\n"
+
+	tests := []struct {
+		name        string
+		ignoreRange bool
+		offset      int64
+		want        string
+	}{
+		{name: "whole log", offset: 0, want: content},
+		{name: "from offset", offset: 7, want: "line 2\nline 3\n"},
+		{name: "nothing new", offset: int64(len(content)), want: ""},
+		{name: "range ignored", ignoreRange: true, offset: 14, want: "line 3\n"},
+		{name: "range ignored and nothing new", ignoreRange: true, offset: int64(len(content)), want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.ignoreRange {
+					fmt.Fprint(w, content)
+					return
+				}
+				http.ServeContent(w, r, "log.txt", time.Time{}, strings.NewReader(content))
+			}))
+			defer srv.Close()
+
+			got, err := downloadLogFrom(context.Background(), srv.URL, tt.offset)
+			if err != nil {
+				t.Fatalf("downloadLogFrom() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("downloadLogFrom() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
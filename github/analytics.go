@@ -0,0 +1,208 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	gogithub "github.com/google/go-github/v68/github"
+
+	"github.com/skanehira/ght/domain"
+)
+
+// ListRecentWorkflowRuns lists up to limit of the newest workflow runs matching
+// opts, of one workflow if workflowID is not 0.
+func ListRecentWorkflowRuns(ctx context.Context, owner, repo string, workflowID int64, opts *gogithub.ListWorkflowRunsOptions, limit int) ([]*gogithub.WorkflowRun, error) {
+	client := GetRESTClient()
+	if client == nil {
+		return nil, fmt.Errorf("REST client not initialized")
+	}
+
+	var allRuns []*gogithub.WorkflowRun
+	opts.ListOptions = gogithub.ListOptions{PerPage: 100}
+
+	for len(allRuns) < limit {
+		var runs *gogithub.WorkflowRuns
+		var resp *gogithub.Response
+		var err error
+		if workflowID > 0 {
+			runs, resp, err = client.Actions.ListWorkflowRunsByID(ctx, owner, repo, workflowID, opts)
+		} else {
+			runs, resp, err = client.Actions.ListRepositoryWorkflowRuns(ctx, owner, repo, opts)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list workflow runs: %w", err)
+		}
+		allRuns = append(allRuns, runs.WorkflowRuns...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	if len(allRuns) > limit {
+		allRuns = allRuns[:limit]
+	}
+	return allRuns, nil
+}
+
+// runDuration returns how long a run that succeeded or failed took. Other
+// runs, like cancelled ones, stopped early and would skew the durations.
+func runDuration(run *gogithub.WorkflowRun) (time.Duration, bool) {
+	if run.GetStatus() != "completed" || run.RunStartedAt == nil {
+		return 0, false
+	}
+	switch run.GetConclusion() {
+	case "success", "failure":
+		return run.GetUpdatedAt().Time.Sub(run.RunStartedAt.Time), true
+	}
+	return 0, false
+}
+
+// ComputeWorkflowStats aggregates runs, as listed newest first, by workflow,
+// most active workflows first. Cancelled and skipped runs do not count for
+// the success rate, and only runs that succeeded or failed for the durations.
+func ComputeWorkflowStats(runs []*gogithub.WorkflowRun) []*domain.WorkflowStats {
+	var stats []*domain.WorkflowStats
+	byName := map[string]*domain.WorkflowStats{}
+	durations := map[string][]time.Duration{}
+
+	// oldest first, so the sparklines read from left to right
+	for i := len(runs) - 1; i >= 0; i-- {
+		run := runs[i]
+		name := run.GetName()
+		s, ok := byName[name]
+		if !ok {
+			s = &domain.WorkflowStats{Workflow: name}
+			byName[name] = s
+			stats = append(stats, s)
+		}
+
+		s.Runs++
+		switch run.GetConclusion() {
+		case "success":
+			s.Successes++
+		case "failure", "timed_out", "startup_failure":
+			s.Failures++
+		}
+
+		if d, ok := runDuration(run); ok {
+			durations[name] = append(durations[name], d)
+			s.Durations = append(s.Durations, d.Seconds())
+		}
+	}
+
+	for _, s := range stats {
+		if s.Successes+s.Failures > 0 {
+			s.SuccessRate = float64(s.Successes) / float64(s.Successes+s.Failures)
+		}
+		if ds := durations[s.Workflow]; len(ds) > 0 {
+			s.Median = formatDuration(percentile(ds, 50))
+			s.P95 = formatDuration(percentile(ds, 95))
+		}
+	}
+
+	sort.SliceStable(stats, func(i, j int) bool {
+		return stats[i].Runs > stats[j].Runs
+	})
+	return stats
+}
+
+// ComputeFailureStreaks finds the workflows whose newest completed runs on a
+// branch failed, longest streaks first. runs must be listed newest first.
+func ComputeFailureStreaks(runs []*gogithub.WorkflowRun) []*domain.FailureStreak {
+	var streaks []*domain.FailureStreak
+	byKey := map[string]*domain.FailureStreak{}
+	ended := map[string]bool{}
+
+	for _, run := range runs {
+		if run.GetStatus() != "completed" {
+			continue
+		}
+		switch run.GetConclusion() {
+		case "cancelled", "skipped":
+			continue
+		}
+
+		key := run.GetName() + "\x00" + run.GetHeadBranch()
+		if ended[key] {
+			continue
+		}
+		if run.GetConclusion() == "success" {
+			ended[key] = true
+			continue
+		}
+
+		s, ok := byKey[key]
+		if !ok {
+			s = &domain.FailureStreak{Workflow: run.GetName(), Branch: run.GetHeadBranch()}
+			byKey[key] = s
+			streaks = append(streaks, s)
+		}
+		s.Failures++
+		s.Since = formatTime(run.GetCreatedAt().Time)
+	}
+
+	sort.SliceStable(streaks, func(i, j int) bool {
+		return streaks[i].Failures > streaks[j].Failures
+	})
+	return streaks
+}
+
+// ComputeSlowestJobs aggregates the durations of completed jobs by workflow
+// and job name and returns the n jobs with the highest median duration.
+func ComputeSlowestJobs(jobs []*gogithub.WorkflowJob, n int) []*domain.JobStats {
+	type key struct{ workflow, name string }
+	var keys []key
+	durations := map[key][]time.Duration{}
+
+	for _, job := range jobs {
+		if job.GetStatus() != "completed" || job.StartedAt == nil || job.CompletedAt == nil {
+			continue
+		}
+		k := key{job.GetWorkflowName(), job.GetName()}
+		if _, ok := durations[k]; !ok {
+			keys = append(keys, k)
+		}
+		durations[k] = append(durations[k], job.CompletedAt.Time.Sub(job.StartedAt.Time))
+	}
+
+	medians := map[key]time.Duration{}
+	for _, k := range keys {
+		medians[k] = percentile(durations[k], 50)
+	}
+	sort.SliceStable(keys, func(i, j int) bool {
+		return medians[keys[i]] > medians[keys[j]]
+	})
+	if len(keys) > n {
+		keys = keys[:n]
+	}
+
+	stats := make([]*domain.JobStats, len(keys))
+	for i, k := range keys {
+		stats[i] = &domain.JobStats{
+			Workflow: k.workflow,
+			Name:     k.name,
+			Runs:     len(durations[k]),
+			Median:   formatDuration(medians[k]),
+			Max:      formatDuration(percentile(durations[k], 100)),
+		}
+	}
+	return stats
+}
+
+// percentile returns the p-th percentile (0 to 100) of durations using the
+// nearest-rank method. durations must not be empty.
+func percentile(durations []time.Duration, p float64) time.Duration {
+	sorted := append([]time.Duration{}, durations...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
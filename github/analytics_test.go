@@ -0,0 +1,128 @@
+package github
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	gogithub "github.com/google/go-github/v68/github"
+)
+
+func newTestRun(name, branch, conclusion string, duration time.Duration) *gogithub.WorkflowRun {
+	started := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	return &gogithub.WorkflowRun{
+		Name:         gogithub.Ptr(name),
+		HeadBranch:   gogithub.Ptr(branch),
+		Status:       gogithub.Ptr("completed"),
+		Conclusion:   gogithub.Ptr(conclusion),
+		RunStartedAt: &gogithub.Timestamp{Time: started},
+		UpdatedAt:    &gogithub.Timestamp{Time: started.Add(duration)},
+		CreatedAt:    &gogithub.Timestamp{Time: started},
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	durations := []time.Duration{5, 1, 4, 2, 3, 6, 7, 8, 9, 10}
+
+	tests := []struct {
+		p    float64
+		want time.Duration
+	}{
+		{p: 0, want: 1},
+		{p: 50, want: 5},
+		{p: 95, want: 10},
+		{p: 100, want: 10},
+	}
+
+	for _, tt := range tests {
+		if got := percentile(durations, tt.p); got != tt.want {
+			t.Errorf("percentile(%v) = %d, want %d", tt.p, got, tt.want)
+		}
+	}
+}
+
+func TestComputeWorkflowStats(t *testing.T) {
+	// newest first, as listed by the API
+	runs := []*gogithub.WorkflowRun{
+		newTestRun("CI", "main", "success", 3*time.Minute),
+		newTestRun("CI", "main", "failure", 1*time.Minute),
+		newTestRun("Release", "main", "success", 10*time.Minute),
+		newTestRun("CI", "main", "cancelled", 30*time.Second),
+		newTestRun("CI", "main", "success", 2*time.Minute),
+	}
+
+	stats := ComputeWorkflowStats(runs)
+	if len(stats) != 2 {
+		t.Fatalf("got %d workflows, want 2", len(stats))
+	}
+
+	ci := stats[0]
+	if ci.Workflow != "CI" || ci.Runs != 4 {
+		t.Fatalf("first workflow = %s with %d runs, want CI with 4 runs", ci.Workflow, ci.Runs)
+	}
+	if ci.Successes != 2 || ci.Failures != 1 {
+		t.Errorf("successes, failures = %d, %d, want 2, 1", ci.Successes, ci.Failures)
+	}
+	if want := 2.0 / 3.0; ci.SuccessRate != want {
+		t.Errorf("SuccessRate = %v, want %v", ci.SuccessRate, want)
+	}
+	// the cancelled run does not count for the durations
+	if ci.Median != "2m 0s" || ci.P95 != "3m 0s" {
+		t.Errorf("Median, P95 = %q, %q, want %q, %q", ci.Median, ci.P95, "2m 0s", "3m 0s")
+	}
+	if want := []float64{120, 60, 180}; !reflect.DeepEqual(ci.Durations, want) {
+		t.Errorf("Durations = %v, want %v (oldest first)", ci.Durations, want)
+	}
+}
+
+func TestComputeFailureStreaks(t *testing.T) {
+	runs := []*gogithub.WorkflowRun{
+		newTestRun("CI", "main", "failure", time.Minute),
+		newTestRun("CI", "feature", "success", time.Minute),
+		newTestRun("CI", "main", "cancelled", time.Minute),
+		newTestRun("CI", "main", "failure", time.Minute),
+		newTestRun("CI", "feature", "failure", time.Minute),
+		newTestRun("CI", "main", "success", time.Minute),
+		newTestRun("CI", "main", "failure", time.Minute),
+	}
+
+	streaks := ComputeFailureStreaks(runs)
+	if len(streaks) != 1 {
+		t.Fatalf("got %d streaks, want 1", len(streaks))
+	}
+	if s := streaks[0]; s.Branch != "main" || s.Failures != 2 {
+		t.Errorf("streak = %d failures on %s, want 2 on main", s.Failures, s.Branch)
+	}
+}
+
+func TestComputeSlowestJobs(t *testing.T) {
+	job := func(name string, d time.Duration) *gogithub.WorkflowJob {
+		started := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+		return &gogithub.WorkflowJob{
+			Name:         gogithub.Ptr(name),
+			WorkflowName: gogithub.Ptr("CI"),
+			Status:       gogithub.Ptr("completed"),
+			StartedAt:    &gogithub.Timestamp{Time: started},
+			CompletedAt:  &gogithub.Timestamp{Time: started.Add(d)},
+		}
+	}
+
+	jobs := []*gogithub.WorkflowJob{
+		job("lint", time.Minute),
+		job("test", 5*time.Minute),
+		job("test", 7*time.Minute),
+		job("build", 3*time.Minute),
+		{Name: gogithub.Ptr("deploy"), Status: gogithub.Ptr("in_progress")},
+	}
+
+	stats := ComputeSlowestJobs(jobs, 2)
+	if len(stats) != 2 {
+		t.Fatalf("got %d jobs, want 2", len(stats))
+	}
+	if s := stats[0]; s.Name != "test" || s.Runs != 2 || s.Median != "5m 0s" || s.Max != "7m 0s" {
+		t.Errorf("slowest = %s (%d runs, median %s, max %s), want test (2 runs, median 5m 0s, max 7m 0s)", s.Name, s.Runs, s.Median, s.Max)
+	}
+	if stats[1].Name != "build" {
+		t.Errorf("second slowest = %s, want build", stats[1].Name)
+	}
+}
@@ -0,0 +1,210 @@
+package github
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	gogithub "github.com/google/go-github/v68/github"
+
+	"github.com/skanehira/ght/domain"
+)
+
+const (
+	// maxArtifactSize is the largest artifact that is read into memory for browsing.
+	maxArtifactSize = 100 * 1024 * 1024
+
+	// maxArtifactPreviewSize is the largest file of an artifact that is previewed.
+	maxArtifactPreviewSize = 1024 * 1024
+)
+
+// ListWorkflowRunArtifacts lists all artifacts of a workflow run with full pagination.
+func ListWorkflowRunArtifacts(ctx context.Context, owner, repo string, runID int64) ([]*gogithub.Artifact, error) {
+	client := GetRESTClient()
+	if client == nil {
+		return nil, fmt.Errorf("REST client not initialized")
+	}
+
+	var allArtifacts []*gogithub.Artifact
+	opts := &gogithub.ListOptions{PerPage: 100}
+
+	for {
+		list, resp, err := client.Actions.ListWorkflowRunArtifacts(ctx, owner, repo, runID, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list artifacts for run %d: %w", runID, err)
+		}
+		allArtifacts = append(allArtifacts, list.Artifacts...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return allArtifacts, nil
+}
+
+// ConvertArtifact converts a go-github Artifact to a domain Artifact.
+func ConvertArtifact(artifact *gogithub.Artifact) *domain.Artifact {
+	var expires string
+	if artifact.ExpiresAt != nil {
+		expires = formatTime(artifact.ExpiresAt.Time)
+	}
+
+	return &domain.Artifact{
+		ID:        artifact.GetID(),
+		Name:      artifact.GetName(),
+		Size:      formatSize(artifact.GetSizeInBytes()),
+		ExpiresAt: expires,
+		Expired:   artifact.GetExpired(),
+	}
+}
+
+// DownloadArtifact writes the zip archive of an artifact to w.
+func DownloadArtifact(ctx context.Context, owner, repo string, artifactID int64, w io.Writer) error {
+	body, err := getArtifactArchive(ctx, owner, repo, artifactID)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	if _, err := io.Copy(w, body); err != nil {
+		return fmt.Errorf("failed to download artifact %d: %w", artifactID, err)
+	}
+	return nil
+}
+
+// ReadArtifact downloads the zip archive of an artifact into memory so its
+// files can be listed and read without extracting them to disk.
+func ReadArtifact(ctx context.Context, owner, repo string, artifactID int64) (*zip.Reader, error) {
+	body, err := getArtifactArchive(ctx, owner, repo, artifactID)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(body, maxArtifactSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to download artifact %d: %w", artifactID, err)
+	}
+	if len(data) > maxArtifactSize {
+		return nil, fmt.Errorf("artifact %d is larger than %s, download it instead", artifactID, formatSize(maxArtifactSize))
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open artifact %d: %w", artifactID, err)
+	}
+	return r, nil
+}
+
+// getArtifactArchive opens the zip archive of an artifact. The caller must close it.
+func getArtifactArchive(ctx context.Context, owner, repo string, artifactID int64) (io.ReadCloser, error) {
+	client := GetRESTClient()
+	if client == nil {
+		return nil, fmt.Errorf("REST client not initialized")
+	}
+
+	archiveURL, _, err := client.Actions.DownloadArtifact(ctx, owner, repo, artifactID, 4)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get download URL for artifact %d: %w", artifactID, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, archiveURL.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create artifact request: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download artifact %d: %w", artifactID, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("artifact download returned status %d", resp.StatusCode)
+	}
+	return resp.Body, nil
+}
+
+// ListArtifactFiles lists the files in the zip archive of an artifact.
+func ListArtifactFiles(r *zip.Reader) []*domain.ArtifactFile {
+	var files []*domain.ArtifactFile
+	for _, f := range r.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		files = append(files, &domain.ArtifactFile{
+			Name: f.Name,
+			Size: formatSize(int64(f.UncompressedSize64)),
+		})
+	}
+	return files
+}
+
+// ReadArtifactFile returns the content of a text file in the zip archive of
+// an artifact. Binary files are not returned and large files are truncated.
+func ReadArtifactFile(r *zip.Reader, name string) (string, error) {
+	for _, f := range r.File {
+		if f.Name != name {
+			continue
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			return "", fmt.Errorf("failed to open %s: %w", name, err)
+		}
+		defer rc.Close()
+
+		data, err := io.ReadAll(io.LimitReader(rc, maxArtifactPreviewSize))
+		if err != nil {
+			return "", fmt.Errorf("failed to read %s: %w", name, err)
+		}
+		if !isText(data) {
+			return "", fmt.Errorf("%s is a binary file", name)
+		}
+
+		text := string(data)
+		if f.UncompressedSize64 > maxArtifactPreviewSize {
+			text += fmt.Sprintf("\n\n--- Preview truncated at %s. ---", formatSize(maxArtifactPreviewSize))
+		}
+		return text, nil
+	}
+	return "", fmt.Errorf("%s not found in artifact", name)
+}
+
+// isText reports whether data looks like text. A multi-byte character cut by
+// the preview limit does not make it binary.
+func isText(data []byte) bool {
+	if bytes.IndexByte(data, 0) >= 0 {
+		return false
+	}
+	for len(data) > 0 {
+		r, size := utf8.DecodeRune(data)
+		if r == utf8.RuneError && size == 1 && len(data) >= utf8.UTFMax {
+			return false
+		}
+		data = data[size:]
+	}
+	return true
+}
+
+// formatSize formats a byte count for display, e.g. "512 B" or "1.5 MB".
+func formatSize(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	size := fmt.Sprintf("%.1f", float64(n)/float64(div))
+	return strings.TrimSuffix(size, ".0") + " " + string("KMGT"[exp]) + "B"
+}
@@ -0,0 +1,130 @@
+package github
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+
+	gogithub "github.com/google/go-github/v68/github"
+)
+
+func TestFormatSize(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int64
+		want string
+	}{
+		{name: "bytes", n: 512, want: "512 B"},
+		{name: "exact kilobytes", n: 2048, want: "2 KB"},
+		{name: "fractional megabytes", n: 1536 * 1024, want: "1.5 MB"},
+		{name: "gigabytes", n: 3 * 1024 * 1024 * 1024, want: "3 GB"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatSize(tt.n); got != tt.want {
+				t.Errorf("formatSize(%d) = %q, want %q", tt.n, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertArtifact(t *testing.T) {
+	artifact := &gogithub.Artifact{
+		ID:          gogithub.Ptr(int64(10)),
+		Name:        gogithub.Ptr("coverage"),
+		SizeInBytes: gogithub.Ptr(int64(2048)),
+		Expired:     gogithub.Ptr(true),
+	}
+
+	got := ConvertArtifact(artifact)
+	if got.ID != 10 || got.Name != "coverage" {
+		t.Errorf("ID, Name = %d, %q, want 10, %q", got.ID, got.Name, "coverage")
+	}
+	if got.Size != "2 KB" {
+		t.Errorf("Size = %q, want %q", got.Size, "2 KB")
+	}
+	if fields := got.Fields(); fields[2].Text != "expired" {
+		t.Errorf("expires field = %q, want %q", fields[2].Text, "expired")
+	}
+}
+
+func newTestZip(t *testing.T, files map[string][]byte) *zip.Reader {
+	t.Helper()
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	if _, err := w.Create("reports/"); err != nil {
+		t.Fatalf("failed to create dir: %v", err)
+	}
+	for name, data := range files {
+		f, err := w.Create(name)
+		if err != nil {
+			t.Fatalf("failed to create %s: %v", name, err)
+		}
+		if _, err := f.Write(data); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("failed to close zip: %v", err)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("failed to open zip: %v", err)
+	}
+	return r
+}
+
+func TestListArtifactFiles(t *testing.T) {
+	r := newTestZip(t, map[string][]byte{
+		"reports/junit.xml": []byte("<testsuite/>"),
+	})
+
+	files := ListArtifactFiles(r)
+	if len(files) != 1 {
+		t.Fatalf("got %d files, want 1 (directories are skipped)", len(files))
+	}
+	if files[0].Name != "reports/junit.xml" || files[0].Size != "12 B" {
+		t.Errorf("file = %s (%s), want reports/junit.xml (12 B)", files[0].Name, files[0].Size)
+	}
+}
+
+func TestReadArtifactFile(t *testing.T) {
+	r := newTestZip(t, map[string][]byte{
+		"coverage.txt": []byte("mode: set\nmain.go:1.1,2.2 1 1\n"),
+		"report.png":   {0x89, 'P', 'N', 'G', 0x00, 0x01},
+		"large.log":    []byte(strings.Repeat("a", maxArtifactPreviewSize+1)),
+	})
+
+	tests := []struct {
+		name       string
+		file       string
+		wantPrefix string
+		wantErr    bool
+	}{
+		{name: "text file", file: "coverage.txt", wantPrefix: "mode: set\n"},
+		{name: "binary file", file: "report.png", wantErr: true},
+		{name: "missing file", file: "missing.txt", wantErr: true},
+		{name: "large file", file: "large.log", wantPrefix: "aaa"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadArtifactFile(r, tt.file)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ReadArtifactFile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !strings.HasPrefix(got, tt.wantPrefix) {
+				t.Errorf("ReadArtifactFile() = %.20q, want prefix %q", got, tt.wantPrefix)
+			}
+		})
+	}
+
+	got, _ := ReadArtifactFile(r, "large.log")
+	if !strings.HasSuffix(got, "--- Preview truncated at 1 MB. ---") {
+		t.Errorf("large file is not marked as truncated: %.40q", got[len(got)-40:])
+	}
+}
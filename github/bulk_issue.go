@@ -0,0 +1,249 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/shurcooL/githubv4"
+)
+
+const (
+	// bulkIssueWorkers is how many issues a bulk edit changes at once. Their
+	// requests are throttled further by the RateLimiter of the transport.
+	bulkIssueWorkers = 4
+
+	// bulkIssueLimitThreshold is the fraction of the GraphQL rate limit below
+	// which a bulk edit changes one issue at a time.
+	bulkIssueLimitThreshold = 0.1
+)
+
+// IssueChangeSet is a set of changes applied to many issues at once.
+type IssueChangeSet struct {
+	AddLabelIDs       []githubv4.ID
+	RemoveLabelIDs    []githubv4.ID
+	AddAssigneeIDs    []githubv4.ID
+	RemoveAssigneeIDs []githubv4.ID
+	// MilestoneID is the milestone to set, or nil to keep the milestones.
+	MilestoneID *githubv4.ID
+	// RemoveMilestone removes the milestones if MilestoneID is nil.
+	RemoveMilestone  bool
+	AddProjectIDs    []githubv4.ID
+	RemoveProjectIDs []githubv4.ID
+	// Comment is added to every issue if it is not empty.
+	Comment string
+	// CloseReason closes the issues for the reason if it is not empty.
+	CloseReason IssueClosedStateReason
+}
+
+// Empty reports whether the change set changes nothing.
+func (c *IssueChangeSet) Empty() bool {
+	return len(c.AddLabelIDs) == 0 && len(c.RemoveLabelIDs) == 0 &&
+		len(c.AddAssigneeIDs) == 0 && len(c.RemoveAssigneeIDs) == 0 &&
+		c.MilestoneID == nil && !c.RemoveMilestone &&
+		len(c.AddProjectIDs) == 0 && len(c.RemoveProjectIDs) == 0 &&
+		c.Comment == "" && c.CloseReason == ""
+}
+
+// ApplyIssueChangeSet applies the change set to the issues with bounded
+// parallelism and returns the error of each issue, in the order of issueIDs.
+func ApplyIssueChangeSet(ctx context.Context, issueIDs []string, changes *IssueChangeSet) []error {
+	workers := bulkIssueWorkers
+	if rl := GetRateLimiter(); rl != nil {
+		if _, graphql := rl.IsApproachingLimit(bulkIssueLimitThreshold); graphql {
+			workers = 1
+		}
+	}
+
+	errs := make([]error, len(issueIDs))
+	forEachBounded(len(issueIDs), workers, func(i int) {
+		errs[i] = applyIssueChanges(ctx, issueIDs[i], changes)
+	})
+	return errs
+}
+
+// IssueChangeError is the error of a change to an issue, with the changes
+// that were applied to it before.
+type IssueChangeError struct {
+	Applied []string
+	Failed  string
+	Err     error
+}
+
+func (e *IssueChangeError) Error() string {
+	if len(e.Applied) == 0 {
+		return fmt.Sprintf("failed to %s: %s", e.Failed, e.Err)
+	}
+	return fmt.Sprintf("failed to %s: %s (already done: %s)", e.Failed, e.Err, strings.Join(e.Applied, ", "))
+}
+
+func (e *IssueChangeError) Unwrap() error {
+	return e.Err
+}
+
+// issueStep is one mutation of an issue.
+type issueStep struct {
+	name string
+	run  func() error
+}
+
+// runIssueSteps runs the steps in order and stops at the first that fails,
+// returning an IssueChangeError that names the steps done before it.
+func runIssueSteps(steps []issueStep) error {
+	var applied []string
+	for _, step := range steps {
+		if err := step.run(); err != nil {
+			return &IssueChangeError{Applied: applied, Failed: step.name, Err: err}
+		}
+		applied = append(applied, step.name)
+	}
+	return nil
+}
+
+// applyIssueChanges applies the change set to one issue, stopping at the first
+// change that fails.
+func applyIssueChanges(ctx context.Context, id string, changes *IssueChangeSet) error {
+	return runIssueSteps(issueChangeSteps(ctx, id, changes))
+}
+
+// issueChangeSteps returns the steps that apply the change set to an issue.
+// The comment is added before the issue is closed so that it explains the
+// closing.
+func issueChangeSteps(ctx context.Context, id string, changes *IssueChangeSet) []issueStep {
+	issueID := githubv4.ID(id)
+
+	var steps []issueStep
+	if len(changes.AddLabelIDs) > 0 {
+		steps = append(steps, issueStep{"add labels", func() error {
+			return AddLabels(ctx, id, changes.AddLabelIDs)
+		}})
+	}
+	if len(changes.RemoveLabelIDs) > 0 {
+		steps = append(steps, issueStep{"remove labels", func() error {
+			return RemoveLabels(ctx, id, changes.RemoveLabelIDs)
+		}})
+	}
+	if len(changes.AddAssigneeIDs) > 0 {
+		steps = append(steps, issueStep{"add assignees", func() error {
+			return AddAssignees(ctx, id, changes.AddAssigneeIDs)
+		}})
+	}
+	if len(changes.RemoveAssigneeIDs) > 0 {
+		steps = append(steps, issueStep{"remove assignees", func() error {
+			return RemoveAssignees(ctx, id, changes.RemoveAssigneeIDs)
+		}})
+	}
+	if changes.MilestoneID != nil || changes.RemoveMilestone {
+		steps = append(steps, issueStep{"set the milestone", func() error {
+			return SetIssueMilestone(ctx, id, changes.MilestoneID)
+		}})
+	}
+	if len(changes.AddProjectIDs) > 0 || len(changes.RemoveProjectIDs) > 0 {
+		steps = append(steps, issueStep{"update the projects", func() error {
+			return updateIssueProjects(ctx, id, changes.AddProjectIDs, changes.RemoveProjectIDs)
+		}})
+	}
+	if changes.Comment != "" {
+		steps = append(steps, issueStep{"add the comment", func() error {
+			var m MutateAddIssueComment
+			input := githubv4.AddCommentInput{SubjectID: issueID, Body: githubv4.String(changes.Comment)}
+			return graphQLClient.Mutate(ctx, &m, input, nil)
+		}})
+	}
+	if changes.CloseReason != "" {
+		steps = append(steps, issueStep{"close the issue", func() error {
+			var m MutateCoseIssue
+			reason := changes.CloseReason
+			input := CloseIssueInput{IssueID: issueID, StateReason: &reason}
+			return graphQLClient.Mutate(ctx, &m, input, nil)
+		}})
+	}
+	return steps
+}
+
+// updateIssueProjects adds and removes projects of an issue. updateIssue
+// replaces all projects of an issue, so all its project cards are fetched
+// first; the projects missing from the list would be removed.
+func updateIssueProjects(ctx context.Context, id string, add, remove []githubv4.ID) error {
+	v := map[string]interface{}{
+		"id":     githubv4.ID(id),
+		"first":  githubv4.Int(100),
+		"cursor": (*githubv4.String)(nil),
+	}
+
+	var current []githubv4.ID
+	for {
+		resp, err := GetIssueProjectCards(ctx, v)
+		if err != nil {
+			return err
+		}
+		for _, card := range resp.Nodes {
+			current = append(current, card.Project.ID)
+		}
+		if !resp.PageInfo.HasNextPage {
+			break
+		}
+		v["cursor"] = githubv4.NewString(resp.PageInfo.EndCursor)
+	}
+
+	removed := map[githubv4.ID]bool{}
+	for _, projectID := range remove {
+		removed[projectID] = true
+	}
+	var projects []githubv4.ID
+	has := map[githubv4.ID]bool{}
+	changed := false
+	for _, projectID := range current {
+		has[projectID] = true
+		if removed[projectID] {
+			changed = true
+			continue
+		}
+		projects = append(projects, projectID)
+	}
+	for _, projectID := range add {
+		if !has[projectID] && !removed[projectID] {
+			has[projectID] = true
+			projects = append(projects, projectID)
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+
+	// an empty list, not a nil one, removes the last project
+	if projects == nil {
+		projects = []githubv4.ID{}
+	}
+	input := githubv4.UpdateIssueInput{
+		ID:         githubv4.ID(id),
+		ProjectIDs: &projects,
+	}
+	return UpdateIssue(ctx, input)
+}
+
+// forEachBounded calls fn with 0 to n-1, running at most workers calls at once.
+func forEachBounded(n, workers int, fn func(i int)) {
+	if workers < 1 {
+		workers = 1
+	}
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers && w < n; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				fn(i)
+			}
+		}()
+	}
+	for i := 0; i < n; i++ {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+}
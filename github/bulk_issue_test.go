@@ -0,0 +1,126 @@
+package github
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shurcooL/githubv4"
+)
+
+func TestForEachBounded(t *testing.T) {
+	tests := []struct {
+		name    string
+		n       int
+		workers int
+		wantMax int
+	}{
+		{name: "more items than workers", n: 20, workers: 4, wantMax: 4},
+		{name: "fewer items than workers", n: 2, workers: 4, wantMax: 2},
+		{name: "no items", n: 0, workers: 4, wantMax: 0},
+		{name: "invalid workers", n: 5, workers: 0, wantMax: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mu sync.Mutex
+			running, max := 0, 0
+			done := make([]bool, tt.n)
+
+			forEachBounded(tt.n, tt.workers, func(i int) {
+				mu.Lock()
+				running++
+				if running > max {
+					max = running
+				}
+				mu.Unlock()
+
+				time.Sleep(5 * time.Millisecond)
+
+				mu.Lock()
+				running--
+				done[i] = true
+				mu.Unlock()
+			})
+
+			if max > tt.wantMax {
+				t.Errorf("max concurrent calls = %d, want at most %d", max, tt.wantMax)
+			}
+			for i, d := range done {
+				if !d {
+					t.Errorf("fn(%d) was not called", i)
+				}
+			}
+		})
+	}
+}
+
+func TestIssueChangeSetEmpty(t *testing.T) {
+	milestone := githubv4.ID("M_1")
+
+	tests := []struct {
+		name    string
+		changes IssueChangeSet
+		want    bool
+	}{
+		{name: "nothing", changes: IssueChangeSet{}, want: true},
+		{name: "labels", changes: IssueChangeSet{AddLabelIDs: []githubv4.ID{"L_1"}}, want: false},
+		{name: "unassign", changes: IssueChangeSet{RemoveAssigneeIDs: []githubv4.ID{"U_1"}}, want: false},
+		{name: "milestone", changes: IssueChangeSet{MilestoneID: &milestone}, want: false},
+		{name: "remove milestone", changes: IssueChangeSet{RemoveMilestone: true}, want: false},
+		{name: "projects", changes: IssueChangeSet{RemoveProjectIDs: []githubv4.ID{"P_1"}}, want: false},
+		{name: "comment", changes: IssueChangeSet{Comment: "duplicate of #1"}, want: false},
+		{name: "close", changes: IssueChangeSet{CloseReason: IssueClosedStateReasonNotPlanned}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.changes.Empty(); got != tt.want {
+				t.Errorf("Empty() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunIssueSteps(t *testing.T) {
+	errFailed := errors.New("forbidden")
+	var ran []string
+	step := func(name string, err error) issueStep {
+		return issueStep{name, func() error {
+			ran = append(ran, name)
+			return err
+		}}
+	}
+
+	err := runIssueSteps([]issueStep{
+		step("update the issue", nil),
+		step("add labels", nil),
+		step("add assignees", errFailed),
+		step("close the issue", nil),
+	})
+
+	if want := []string{"update the issue", "add labels", "add assignees"}; !reflect.DeepEqual(ran, want) {
+		t.Errorf("ran steps %q, want %q", ran, want)
+	}
+	var changeErr *IssueChangeError
+	if !errors.As(err, &changeErr) {
+		t.Fatalf("runIssueSteps() error = %v, want an IssueChangeError", err)
+	}
+	if !errors.Is(err, errFailed) {
+		t.Errorf("runIssueSteps() error does not wrap the error of the step")
+	}
+	if want := "failed to add assignees: forbidden (already done: update the issue, add labels)"; err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+
+	first := runIssueSteps([]issueStep{step("add labels", errFailed)})
+	if want := "failed to add labels: forbidden"; first.Error() != want {
+		t.Errorf("Error() = %q, want %q", first.Error(), want)
+	}
+
+	if err := runIssueSteps(nil); err != nil {
+		t.Errorf("runIssueSteps() without steps error = %v", err)
+	}
+}
@@ -99,6 +99,85 @@ func GetIssue(variables map[string]interface{}) (*Issue, error) {
 	return q.Repository.Issue, nil
 }
 
+// GetIssueComments returns a page of the comments of an issue. The page is
+// after $after with $first comments, or before $before with $last comments.
+func GetIssueComments(variables map[string]interface{}) (*Comments, error) {
+	var q struct {
+		Repository struct {
+			Issue struct {
+				Comments Comments `graphql:"comments(first: $first, after: $after, last: $last, before: $before)"`
+			} `graphql:"issue(number: $number)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+
+	if err := graphQLClient.Query(context.Background(), &q, variables); err != nil {
+		return nil, err
+	}
+	return &q.Repository.Issue.Comments, nil
+}
+
+// GetIssueProjectCards returns a page of the project cards of the issue with
+// the node ID in the id variable.
+func GetIssueProjectCards(ctx context.Context, variables map[string]interface{}) (*ProjectCards, error) {
+	var q struct {
+		Node struct {
+			Issue struct {
+				ProjectCards `graphql:"projectCards(first: $first, after: $cursor)"`
+			} `graphql:"... on Issue"`
+		} `graphql:"node(id: $id)"`
+	}
+
+	if err := graphQLClient.Query(ctx, &q, variables); err != nil {
+		return nil, err
+	}
+	return &q.Node.Issue.ProjectCards, nil
+}
+
+// GetIssueTimeline returns a page of the comments and events of an issue in
+// chronological order.
+func GetIssueTimeline(variables map[string]interface{}) (*TimelineItems, error) {
+	var q struct {
+		Repository struct {
+			Issue struct {
+				TimelineItems TimelineItems `graphql:"timelineItems(first: $first, after: $cursor, itemTypes: [ISSUE_COMMENT, LABELED_EVENT, UNLABELED_EVENT, ASSIGNED_EVENT, UNASSIGNED_EVENT, CLOSED_EVENT, REOPENED_EVENT, RENAMED_TITLE_EVENT, CROSS_REFERENCED_EVENT, CONNECTED_EVENT, REFERENCED_EVENT])"`
+			} `graphql:"issue(number: $number)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+
+	if err := graphQLClient.Query(context.Background(), &q, variables); err != nil {
+		return nil, err
+	}
+	return &q.Repository.Issue.TimelineItems, nil
+}
+
+func GetPullRequests(variables map[string]interface{}) (*PullRequests, error) {
+	var q struct {
+		Search PullRequests `graphql:"search(query: $query, type: ISSUE, first: $first, after: $cursor)"`
+	}
+	if err := graphQLClient.Query(context.Background(), &q, variables); err != nil {
+		return nil, err
+	}
+
+	pullRequests := &PullRequests{
+		Nodes:    q.Search.Nodes,
+		PageInfo: q.Search.PageInfo,
+	}
+	return pullRequests, nil
+}
+
+func GetPullRequestMergeStatus(variables map[string]interface{}) (*PullRequestMergeStatus, error) {
+	var q struct {
+		Repository struct {
+			PullRequest *PullRequestMergeStatus `graphql:"pullRequest(number: $number)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+
+	if err := graphQLClient.Query(context.Background(), &q, variables); err != nil {
+		return nil, err
+	}
+	return q.Repository.PullRequest, nil
+}
+
 func GetIssueTemplates(variables map[string]interface{}) ([]IssueTemplate, error) {
 	var q struct {
 		Repository struct {
@@ -111,6 +190,39 @@ func GetIssueTemplates(variables map[string]interface{}) ([]IssueTemplate, error
 	return q.Repository.IssueTemplates, nil
 }
 
+func GetRepoBranches(variables map[string]interface{}) (*Refs, error) {
+	var q struct {
+		Repository struct {
+			Refs `graphql:"refs(refPrefix: \"refs/heads/\", first: $first, after: $cursor, orderBy: {field: TAG_COMMIT_DATE, direction: DESC})"`
+		} `graphql:"repository(name: $name, owner: $owner)"`
+	}
+	if err := graphQLClient.Query(context.Background(), &q, variables); err != nil {
+		return nil, err
+	}
+	return &q.Repository.Refs, nil
+}
+
+// GetRepoFileText returns the text of the blob at $expression (e.g. "HEAD:README.md").
+// It returns an empty string when the file does not exist.
+func GetRepoFileText(variables map[string]interface{}) (string, error) {
+	var q struct {
+		Repository struct {
+			Object *struct {
+				Blob struct {
+					Text githubv4.String
+				} `graphql:"... on Blob"`
+			} `graphql:"object(expression: $expression)"`
+		} `graphql:"repository(name: $name, owner: $owner)"`
+	}
+	if err := graphQLClient.Query(context.Background(), &q, variables); err != nil {
+		return "", err
+	}
+	if q.Repository.Object == nil {
+		return "", nil
+	}
+	return string(q.Repository.Object.Blob.Text), nil
+}
+
 func ReopenIssue(id string) error {
 	input := githubv4.ReopenIssueInput{
 		IssueID: githubv4.String(id),
@@ -186,9 +298,61 @@ func DeleteIssueComment(id string) error {
 	return graphQLClient.Mutate(context.Background(), &m, input, nil)
 }
 
-func UpdateIssue(input githubv4.UpdateIssueInput) error {
+func UpdateIssue(ctx context.Context, input githubv4.UpdateIssueInput) error {
 	var m MutateUpdateIssue
-	return graphQLClient.Mutate(context.Background(), &m, input, nil)
+	return graphQLClient.Mutate(ctx, &m, input, nil)
+}
+
+func AddLabels(ctx context.Context, id string, labelIDs []githubv4.ID) error {
+	input := githubv4.AddLabelsToLabelableInput{
+		LabelableID: githubv4.ID(id),
+		LabelIDs:    labelIDs,
+	}
+
+	var m MutateAddLabelsToLabelable
+	return graphQLClient.Mutate(ctx, &m, input, nil)
+}
+
+func RemoveLabels(ctx context.Context, id string, labelIDs []githubv4.ID) error {
+	input := githubv4.RemoveLabelsFromLabelableInput{
+		LabelableID: githubv4.ID(id),
+		LabelIDs:    labelIDs,
+	}
+
+	var m MutateRemoveLabelsFromLabelable
+	return graphQLClient.Mutate(ctx, &m, input, nil)
+}
+
+func AddAssignees(ctx context.Context, id string, userIDs []githubv4.ID) error {
+	input := githubv4.AddAssigneesToAssignableInput{
+		AssignableID: githubv4.ID(id),
+		AssigneeIDs:  userIDs,
+	}
+
+	var m MutateAddAssigneesToAssignable
+	return graphQLClient.Mutate(ctx, &m, input, nil)
+}
+
+func RemoveAssignees(ctx context.Context, id string, userIDs []githubv4.ID) error {
+	input := githubv4.RemoveAssigneesFromAssignableInput{
+		AssignableID: githubv4.ID(id),
+		AssigneeIDs:  userIDs,
+	}
+
+	var m MutateRemoveAssigneesFromAssignable
+	return graphQLClient.Mutate(ctx, &m, input, nil)
+}
+
+// SetIssueMilestone sets the milestone of an issue, or removes it if
+// milestoneID is nil.
+func SetIssueMilestone(ctx context.Context, id string, milestoneID *githubv4.ID) error {
+	input := UpdateIssueInput{
+		ID:          githubv4.ID(id),
+		MilestoneID: milestoneID,
+	}
+
+	var m MutateUpdateIssue
+	return graphQLClient.Mutate(ctx, &m, input, nil)
 }
 
 func UpdateIssueComment(input githubv4.UpdateIssueCommentInput) error {
@@ -200,3 +364,76 @@ func AddIssueComment(input githubv4.AddCommentInput) error {
 	var m MutateAddIssueComment
 	return graphQLClient.Mutate(context.Background(), &m, input, nil)
 }
+
+func AddPullRequestReview(input githubv4.AddPullRequestReviewInput) error {
+	var m MutateAddPullRequestReview
+	return graphQLClient.Mutate(context.Background(), &m, input, nil)
+}
+
+func MergePullRequest(input githubv4.MergePullRequestInput) error {
+	var m MutateMergePullRequest
+	return graphQLClient.Mutate(context.Background(), &m, input, nil)
+}
+
+func DeleteRef(id githubv4.ID) error {
+	input := githubv4.DeleteRefInput{
+		RefID: id,
+	}
+
+	var m MutateDeleteRef
+	return graphQLClient.Mutate(context.Background(), &m, input, nil)
+}
+
+func CreatePullRequest(input githubv4.CreatePullRequestInput) (githubv4.ID, error) {
+	var m MutateCreatePullRequest
+	if err := graphQLClient.Mutate(context.Background(), &m, input, nil); err != nil {
+		return nil, err
+	}
+	return m.CreatePullRequest.PullRequest.ID, nil
+}
+
+func UpdatePullRequest(input githubv4.UpdatePullRequestInput) error {
+	var m MutateUpdatePullRequest
+	return graphQLClient.Mutate(context.Background(), &m, input, nil)
+}
+
+func RequestReviews(input githubv4.RequestReviewsInput) error {
+	var m MutateRequestReviews
+	return graphQLClient.Mutate(context.Background(), &m, input, nil)
+}
+
+func ClosePullRequest(id string) error {
+	input := githubv4.ClosePullRequestInput{
+		PullRequestID: githubv4.ID(id),
+	}
+
+	var m MutateClosePullRequest
+	return graphQLClient.Mutate(context.Background(), &m, input, nil)
+}
+
+func ReopenPullRequest(id string) error {
+	input := githubv4.ReopenPullRequestInput{
+		PullRequestID: githubv4.ID(id),
+	}
+
+	var m MutateReopenPullRequest
+	return graphQLClient.Mutate(context.Background(), &m, input, nil)
+}
+
+func MarkPullRequestReadyForReview(id string) error {
+	input := githubv4.MarkPullRequestReadyForReviewInput{
+		PullRequestID: githubv4.ID(id),
+	}
+
+	var m MutateMarkPullRequestReadyForReview
+	return graphQLClient.Mutate(context.Background(), &m, input, nil)
+}
+
+func ConvertPullRequestToDraft(id string) error {
+	input := ConvertPullRequestToDraftInput{
+		PullRequestID: githubv4.ID(id),
+	}
+
+	var m MutateConvertPullRequestToDraft
+	return graphQLClient.Mutate(context.Background(), &m, input, nil)
+}
@@ -0,0 +1,86 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gogithub "github.com/google/go-github/v68/github"
+
+	"github.com/skanehira/ght/domain"
+)
+
+// GetPendingDeployments lists the deployments of a workflow run that wait for
+// the review of a protected environment.
+func GetPendingDeployments(ctx context.Context, owner, repo string, runID int64) ([]*gogithub.PendingDeployment, error) {
+	client := GetRESTClient()
+	if client == nil {
+		return nil, fmt.Errorf("REST client not initialized")
+	}
+
+	deployments, _, err := client.Actions.GetPendingDeployments(ctx, owner, repo, runID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending deployments for run %d: %w", runID, err)
+	}
+	return deployments, nil
+}
+
+// ReviewPendingDeployments approves (state "approved") or rejects (state
+// "rejected") the pending deployments of a workflow run to the environments.
+func ReviewPendingDeployments(ctx context.Context, owner, repo string, runID int64, environmentIDs []int64, state, comment string) error {
+	client := GetRESTClient()
+	if client == nil {
+		return fmt.Errorf("REST client not initialized")
+	}
+
+	request := &gogithub.PendingDeploymentsRequest{
+		EnvironmentIDs: environmentIDs,
+		State:          state,
+		Comment:        comment,
+	}
+	if _, _, err := client.Actions.PendingDeployments(ctx, owner, repo, runID, request); err != nil {
+		return fmt.Errorf("failed to review pending deployments for run %d: %w", runID, err)
+	}
+	return nil
+}
+
+// ConvertPendingDeployment converts a go-github PendingDeployment to a domain PendingDeployment.
+func ConvertPendingDeployment(deployment *gogithub.PendingDeployment) *domain.PendingDeployment {
+	var reviewers []string
+	for _, r := range deployment.Reviewers {
+		switch reviewer := r.Reviewer.(type) {
+		case *gogithub.User:
+			reviewers = append(reviewers, reviewer.GetLogin())
+		case *gogithub.Team:
+			reviewers = append(reviewers, "@"+reviewer.GetSlug())
+		}
+	}
+
+	return &domain.PendingDeployment{
+		EnvironmentID: deployment.GetEnvironment().GetID(),
+		Environment:   deployment.GetEnvironment().GetName(),
+		Reviewers:     reviewers,
+		WaitTimer:     formatWaitTimer(deployment, time.Now()),
+		CanApprove:    deployment.GetCurrentUserCanApprove(),
+	}
+}
+
+// formatWaitTimer formats the wait timer of a deployment in minutes, with the
+// time that is left of it once it started, e.g. "30m (12m left)".
+func formatWaitTimer(deployment *gogithub.PendingDeployment, now time.Time) string {
+	timer := time.Duration(deployment.GetWaitTimer()) * time.Minute
+	if timer == 0 {
+		return ""
+	}
+
+	text := formatDuration(timer)
+	if deployment.WaitTimerStartedAt == nil {
+		return text
+	}
+
+	left := deployment.WaitTimerStartedAt.Add(timer).Sub(now)
+	if left <= 0 {
+		return text + " (elapsed)"
+	}
+	return fmt.Sprintf("%s (%s left)", text, formatDuration(left))
+}
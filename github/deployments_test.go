@@ -0,0 +1,65 @@
+package github
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	gogithub "github.com/google/go-github/v68/github"
+)
+
+func TestConvertPendingDeployment(t *testing.T) {
+	deployment := &gogithub.PendingDeployment{
+		Environment: &gogithub.PendingDeploymentEnvironment{
+			ID:   gogithub.Ptr(int64(42)),
+			Name: gogithub.Ptr("production"),
+		},
+		CurrentUserCanApprove: gogithub.Ptr(true),
+		Reviewers: []*gogithub.RequiredReviewer{
+			{Type: gogithub.Ptr("User"), Reviewer: &gogithub.User{Login: gogithub.Ptr("octocat")}},
+			{Type: gogithub.Ptr("Team"), Reviewer: &gogithub.Team{Slug: gogithub.Ptr("release")}},
+		},
+	}
+
+	got := ConvertPendingDeployment(deployment)
+	if got.EnvironmentID != 42 || got.Environment != "production" {
+		t.Errorf("environment = %d %q, want 42 %q", got.EnvironmentID, got.Environment, "production")
+	}
+	if want := []string{"octocat", "@release"}; !reflect.DeepEqual(got.Reviewers, want) {
+		t.Errorf("Reviewers = %v, want %v", got.Reviewers, want)
+	}
+	if !got.CanApprove {
+		t.Error("CanApprove = false, want true")
+	}
+	if got.WaitTimer != "" {
+		t.Errorf("WaitTimer = %q, want empty", got.WaitTimer)
+	}
+}
+
+func TestFormatWaitTimer(t *testing.T) {
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		timer   int64
+		started *gogithub.Timestamp
+		want    string
+	}{
+		{name: "no timer", timer: 0, want: ""},
+		{name: "not started", timer: 30, want: "30m 0s"},
+		{name: "running", timer: 30, started: &gogithub.Timestamp{Time: now.Add(-18 * time.Minute)}, want: "30m 0s (12m 0s left)"},
+		{name: "elapsed", timer: 30, started: &gogithub.Timestamp{Time: now.Add(-time.Hour)}, want: "30m 0s (elapsed)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deployment := &gogithub.PendingDeployment{
+				WaitTimer:          gogithub.Ptr(tt.timer),
+				WaitTimerStartedAt: tt.started,
+			}
+			if got := formatWaitTimer(deployment, now); got != tt.want {
+				t.Errorf("formatWaitTimer() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package github
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/skanehira/ght/domain"
+)
+
+// hunkHeaderRegex matches unified diff hunk headers like "@@ -10,7 +10,8 @@ func main() {".
+var hunkHeaderRegex = regexp.MustCompile(`^@@ -(\d+)(?:,\d+)? \+(\d+)(?:,\d+)? @@`)
+
+// ParsePatch splits a unified diff patch into lines annotated with their kind
+// and their line numbers in the base and head file.
+func ParsePatch(patch string) []domain.DiffLine {
+	if patch == "" {
+		return nil
+	}
+
+	var (
+		lines   []domain.DiffLine
+		oldLine int
+		newLine int
+	)
+
+	for _, text := range strings.Split(strings.TrimSuffix(patch, "\n"), "\n") {
+		line := domain.DiffLine{Text: text}
+
+		switch {
+		case strings.HasPrefix(text, "@@"):
+			line.Kind = domain.DiffLineHunk
+			if m := hunkHeaderRegex.FindStringSubmatch(text); m != nil {
+				oldLine, _ = strconv.Atoi(m[1])
+				newLine, _ = strconv.Atoi(m[2])
+			}
+		case strings.HasPrefix(text, "+"):
+			line.Kind = domain.DiffLineAdded
+			line.NewLine = newLine
+			newLine++
+		case strings.HasPrefix(text, "-"):
+			line.Kind = domain.DiffLineDeleted
+			line.OldLine = oldLine
+			oldLine++
+		case strings.HasPrefix(text, `\`):
+			// "\ No newline at end of file" belongs to neither side
+			line.Kind = domain.DiffLineContext
+		default:
+			line.Kind = domain.DiffLineContext
+			line.OldLine = oldLine
+			line.NewLine = newLine
+			oldLine++
+			newLine++
+		}
+
+		lines = append(lines, line)
+	}
+
+	return lines
+}
@@ -0,0 +1,58 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/skanehira/ght/domain"
+)
+
+func TestParsePatch(t *testing.T) {
+	patch := "@@ -10,4 +10,5 @@ func main() {\n" +
+		" \tfoo()\n" +
+		"-\tbar()\n" +
+		"+\tbaz()\n" +
+		"+\tqux()\n" +
+		" }\n" +
+		"\\ No newline at end of file"
+
+	want := []domain.DiffLine{
+		{Kind: domain.DiffLineHunk, Text: "@@ -10,4 +10,5 @@ func main() {"},
+		{Kind: domain.DiffLineContext, Text: " \tfoo()", OldLine: 10, NewLine: 10},
+		{Kind: domain.DiffLineDeleted, Text: "-\tbar()", OldLine: 11},
+		{Kind: domain.DiffLineAdded, Text: "+\tbaz()", NewLine: 11},
+		{Kind: domain.DiffLineAdded, Text: "+\tqux()", NewLine: 12},
+		{Kind: domain.DiffLineContext, Text: " }", OldLine: 12, NewLine: 13},
+		{Kind: domain.DiffLineContext, Text: "\\ No newline at end of file"},
+	}
+
+	got := ParsePatch(patch)
+	if len(got) != len(want) {
+		t.Fatalf("ParsePatch() returned %d lines, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("line %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestParsePatch_MultipleHunks(t *testing.T) {
+	patch := "@@ -1,2 +1,2 @@\n-a\n+b\n@@ -20 +20,2 @@\n c\n+d\n"
+
+	got := ParsePatch(patch)
+	if len(got) != 6 {
+		t.Fatalf("ParsePatch() returned %d lines, want 6", len(got))
+	}
+	if got[4].OldLine != 20 || got[4].NewLine != 20 {
+		t.Errorf("context line numbers = %d/%d, want 20/20", got[4].OldLine, got[4].NewLine)
+	}
+	if got[5].NewLine != 21 {
+		t.Errorf("added line NewLine = %d, want 21", got[5].NewLine)
+	}
+}
+
+func TestParsePatch_Empty(t *testing.T) {
+	if got := ParsePatch(""); got != nil {
+		t.Errorf("ParsePatch(\"\") = %v, want nil", got)
+	}
+}
@@ -0,0 +1,136 @@
+package github
+
+import (
+	"context"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/skanehira/ght/domain"
+)
+
+// IssueEdit is what an issue is edited to.
+type IssueEdit struct {
+	Title string
+	Body  string
+	// State is OPEN or CLOSED.
+	State string
+	// StateReason is the reason a closed issue is closed for.
+	StateReason IssueClosedStateReason
+	// MilestoneID is the milestone of the issue, or empty for none.
+	MilestoneID string
+	LabelIDs    []string
+	AssigneeIDs []string
+}
+
+// DiffIssue returns the changes that turn issue into edit. update changes the
+// title, body, milestone or reopens the issue, and is nil if none of them
+// changed. changes adds and removes labels and assignees and closes the
+// issue. Only the differences are sent, so labels and assignees the issue
+// holds beyond the ones it was fetched with are kept.
+func DiffIssue(issue *domain.Issue, edit *IssueEdit) (update *UpdateIssueInput, changes *IssueChangeSet) {
+	update = &UpdateIssueInput{ID: githubv4.ID(issue.ID)}
+	var updated bool
+
+	if edit.Title != issue.Title {
+		update.Title = githubv4.NewString(githubv4.String(edit.Title))
+		updated = true
+	}
+	if edit.Body != issue.Body {
+		update.Body = githubv4.NewString(githubv4.String(edit.Body))
+		updated = true
+	}
+
+	// the milestone is always sent, so the current one is sent if unchanged
+	var milestoneID string
+	if len(issue.MileStone) > 0 {
+		milestoneID = issue.MileStone[0].(*domain.Milestone).ID
+	}
+	if edit.MilestoneID != "" {
+		id := githubv4.ID(edit.MilestoneID)
+		update.MilestoneID = &id
+	}
+	if edit.MilestoneID != milestoneID {
+		updated = true
+	}
+
+	changes = &IssueChangeSet{}
+
+	labelIDs := make([]string, len(issue.Labels))
+	for i, l := range issue.Labels {
+		labelIDs[i] = l.(*domain.Label).ID
+	}
+	changes.AddLabelIDs = toIDs(missingIDs(edit.LabelIDs, labelIDs))
+	changes.RemoveLabelIDs = toIDs(missingIDs(labelIDs, edit.LabelIDs))
+
+	assigneeIDs := make([]string, len(issue.Assignees))
+	for i, a := range issue.Assignees {
+		assigneeIDs[i] = a.(*domain.AssignableUser).ID
+	}
+	changes.AddAssigneeIDs = toIDs(missingIDs(edit.AssigneeIDs, assigneeIDs))
+	changes.RemoveAssigneeIDs = toIDs(missingIDs(assigneeIDs, edit.AssigneeIDs))
+
+	// issues closed before close reasons existed have none
+	reason := IssueClosedStateReason(issue.StateReason)
+	if issue.State == "CLOSED" && reason == "" {
+		reason = IssueClosedStateReasonCompleted
+	}
+
+	switch {
+	case edit.State == "OPEN" && issue.State != "OPEN":
+		state := githubv4.IssueStateOpen
+		update.State = &state
+		updated = true
+	case edit.State == "CLOSED" &&
+		(issue.State != "CLOSED" || edit.StateReason != reason):
+		// updateIssue cannot close an issue for a reason, so closeIssue does
+		changes.CloseReason = edit.StateReason
+		if changes.CloseReason == "" {
+			changes.CloseReason = IssueClosedStateReasonCompleted
+		}
+	}
+
+	if !updated {
+		update = nil
+	}
+	return update, changes
+}
+
+// EditIssue updates an issue if update is not nil, then applies changes to
+// it. The changes are separate mutations, so if one fails the returned
+// IssueChangeError names the ones that were applied.
+func EditIssue(ctx context.Context, issueID string, update *UpdateIssueInput, changes *IssueChangeSet) error {
+	var steps []issueStep
+	if update != nil {
+		steps = append(steps, issueStep{"update the issue", func() error {
+			var m MutateUpdateIssue
+			return graphQLClient.Mutate(ctx, &m, *update, nil)
+		}})
+	}
+	steps = append(steps, issueChangeSteps(ctx, issueID, changes)...)
+	return runIssueSteps(steps)
+}
+
+// missingIDs returns the IDs of a that are not in b, in the order of a.
+func missingIDs(a, b []string) []string {
+	in := map[string]bool{}
+	for _, id := range b {
+		in[id] = true
+	}
+	var missing []string
+	for _, id := range a {
+		if !in[id] {
+			missing = append(missing, id)
+		}
+	}
+	return missing
+}
+
+func toIDs(ids []string) []githubv4.ID {
+	if len(ids) == 0 {
+		return nil
+	}
+	result := make([]githubv4.ID, len(ids))
+	for i, id := range ids {
+		result[i] = githubv4.ID(id)
+	}
+	return result
+}
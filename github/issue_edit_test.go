@@ -0,0 +1,164 @@
+package github
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/skanehira/ght/domain"
+)
+
+func TestDiffIssue(t *testing.T) {
+	issue := func() *domain.Issue {
+		return &domain.Issue{
+			ID:        "I_1",
+			State:     "OPEN",
+			Title:     "Crash on start",
+			Body:      "It crashes.",
+			Labels:    []domain.Item{&domain.Label{ID: "L_1", Name: "bug"}},
+			Assignees: []domain.Item{&domain.AssignableUser{ID: "U_1", Login: "alice"}},
+			MileStone: []domain.Item{&domain.Milestone{ID: "M_1", Title: "v1"}},
+		}
+	}
+	unchanged := func() *IssueEdit {
+		return &IssueEdit{
+			Title:       "Crash on start",
+			Body:        "It crashes.",
+			State:       "OPEN",
+			MilestoneID: "M_1",
+			LabelIDs:    []string{"L_1"},
+			AssigneeIDs: []string{"U_1"},
+		}
+	}
+	milestone := githubv4.ID("M_1")
+	open := githubv4.IssueStateOpen
+
+	tests := []struct {
+		name        string
+		issue       func() *domain.Issue
+		edit        func(e *IssueEdit)
+		wantUpdate  *UpdateIssueInput
+		wantChanges *IssueChangeSet
+	}{
+		{
+			name:        "nothing",
+			issue:       issue,
+			edit:        func(e *IssueEdit) {},
+			wantChanges: &IssueChangeSet{},
+		},
+		{
+			name:  "title",
+			issue: issue,
+			edit: func(e *IssueEdit) {
+				e.Title = "Crash on startup"
+			},
+			wantUpdate: &UpdateIssueInput{
+				ID:          "I_1",
+				MilestoneID: &milestone,
+				Title:       githubv4.NewString("Crash on startup"),
+			},
+			wantChanges: &IssueChangeSet{},
+		},
+		{
+			name:  "remove milestone",
+			issue: issue,
+			edit: func(e *IssueEdit) {
+				e.MilestoneID = ""
+			},
+			wantUpdate:  &UpdateIssueInput{ID: "I_1"},
+			wantChanges: &IssueChangeSet{},
+		},
+		{
+			name: "labels in another order",
+			issue: func() *domain.Issue {
+				i := issue()
+				i.Labels = append(i.Labels, &domain.Label{ID: "L_2", Name: "ui"})
+				return i
+			},
+			edit: func(e *IssueEdit) {
+				e.LabelIDs = []string{"L_2", "L_1"}
+			},
+			wantChanges: &IssueChangeSet{},
+		},
+		{
+			name:  "labels and assignees",
+			issue: issue,
+			edit: func(e *IssueEdit) {
+				e.LabelIDs = []string{"L_2", "L_3"}
+				e.AssigneeIDs = nil
+			},
+			wantChanges: &IssueChangeSet{
+				AddLabelIDs:       []githubv4.ID{"L_2", "L_3"},
+				RemoveLabelIDs:    []githubv4.ID{"L_1"},
+				RemoveAssigneeIDs: []githubv4.ID{"U_1"},
+			},
+		},
+		{
+			name:  "close as not planned",
+			issue: issue,
+			edit: func(e *IssueEdit) {
+				e.State = "CLOSED"
+				e.StateReason = IssueClosedStateReasonNotPlanned
+			},
+			wantChanges: &IssueChangeSet{CloseReason: IssueClosedStateReasonNotPlanned},
+		},
+		{
+			name: "closed for the same reason",
+			issue: func() *domain.Issue {
+				i := issue()
+				i.State = "CLOSED"
+				i.StateReason = "NOT_PLANNED"
+				return i
+			},
+			edit: func(e *IssueEdit) {
+				e.State = "CLOSED"
+				e.StateReason = IssueClosedStateReasonNotPlanned
+			},
+			wantChanges: &IssueChangeSet{},
+		},
+		{
+			name: "closed before close reasons existed",
+			issue: func() *domain.Issue {
+				i := issue()
+				i.State = "CLOSED"
+				return i
+			},
+			edit: func(e *IssueEdit) {
+				e.State = "CLOSED"
+				e.StateReason = IssueClosedStateReasonCompleted
+			},
+			wantChanges: &IssueChangeSet{},
+		},
+		{
+			name: "reopen",
+			issue: func() *domain.Issue {
+				i := issue()
+				i.State = "CLOSED"
+				i.StateReason = "COMPLETED"
+				return i
+			},
+			edit: func(e *IssueEdit) {},
+			wantUpdate: &UpdateIssueInput{
+				ID:          "I_1",
+				MilestoneID: &milestone,
+				State:       &open,
+			},
+			wantChanges: &IssueChangeSet{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			edit := unchanged()
+			tt.edit(edit)
+
+			update, changes := DiffIssue(tt.issue(), edit)
+			if !reflect.DeepEqual(update, tt.wantUpdate) {
+				t.Errorf("update = %+v, want %+v", update, tt.wantUpdate)
+			}
+			if !reflect.DeepEqual(changes, tt.wantChanges) {
+				t.Errorf("changes = %+v, want %+v", changes, tt.wantChanges)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package github
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/skanehira/ght/domain"
+)
+
+// lineTimestampRegex captures the timestamp prefix of a raw log line.
+var lineTimestampRegex = regexp.MustCompile(`^(\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}\.\d+Z) `)
+
+const (
+	groupStartMarker = "##[group]"
+	groupEndMarker   = "##[endgroup]"
+)
+
+// stepAt returns the index of the last step from cur on that started at or
+// before t. Skipped steps and steps that did not start have no output.
+func stepAt(steps []*domain.WorkflowStep, cur int, t time.Time) int {
+	for i := cur + 1; i < len(steps); i++ {
+		s := steps[i]
+		if s.StartedAt.IsZero() || s.Conclusion == "skipped" {
+			continue
+		}
+		if t.Before(s.StartedAt) {
+			break
+		}
+		cur = i
+	}
+	return cur
+}
+
+// ParseLogSections splits a cleaned log into plain sections and the groups
+// enclosed by ##[group] and ##[endgroup] markers. Groups start collapsed.
+func ParseLogSections(text string) []*domain.LogSection {
+	if text == "" {
+		return nil
+	}
+
+	var sections []*domain.LogSection
+	var current *domain.LogSection
+
+	lines := strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+	for _, line := range lines {
+		switch {
+		case strings.HasPrefix(line, groupStartMarker):
+			current = &domain.LogSection{
+				Title:     strings.TrimPrefix(line, groupStartMarker),
+				IsGroup:   true,
+				Collapsed: true,
+			}
+			sections = append(sections, current)
+		case strings.HasPrefix(line, groupEndMarker):
+			current = nil
+		default:
+			if current == nil {
+				current = &domain.LogSection{}
+				sections = append(sections, current)
+			}
+			current.Lines = append(current.Lines, line)
+		}
+	}
+	return sections
+}
+
+var (
+	// logCommandRegex matches ##[error], ##[warning] and ##[notice] lines.
+	logCommandRegex = regexp.MustCompile(`^\s*##\[(error|warning|notice)\](.*)$`)
+
+	// workflowCommandRegex matches ::error file=...,line=...::message workflow commands.
+	workflowCommandRegex = regexp.MustCompile(`^\s*::(error|warning|notice)( [^:]*)?::(.*)$`)
+)
+
+// LogLineLevel returns "error", "warning" or "notice" when a log line is one
+// of those workflow commands, and "" otherwise.
+func LogLineLevel(line string) string {
+	if m := logCommandRegex.FindStringSubmatch(line); m != nil {
+		return m[1]
+	}
+	if m := workflowCommandRegex.FindStringSubmatch(line); m != nil {
+		return m[1]
+	}
+	return ""
+}
+
+// ParseLogAnnotations finds the errors, warnings and notices in a cleaned log,
+// from both ##[level] markers and ::level file=...,line=...:: workflow commands.
+func ParseLogAnnotations(text string) []*domain.Annotation {
+	var annotations []*domain.Annotation
+	for i, line := range strings.Split(text, "\n") {
+		if m := logCommandRegex.FindStringSubmatch(line); m != nil {
+			annotations = append(annotations, &domain.Annotation{
+				Level:   m[1],
+				Message: m[2],
+				LogLine: i,
+			})
+			continue
+		}
+
+		if m := workflowCommandRegex.FindStringSubmatch(line); m != nil {
+			a := &domain.Annotation{
+				Level:   m[1],
+				Message: m[3],
+				LogLine: i,
+			}
+			for _, param := range strings.Split(strings.TrimSpace(m[2]), ",") {
+				kv := strings.SplitN(param, "=", 2)
+				if len(kv) != 2 {
+					continue
+				}
+				switch kv[0] {
+				case "file":
+					a.Path = kv[1]
+				case "line":
+					a.Line, _ = strconv.Atoi(kv[1])
+				case "title":
+					a.Title = kv[1]
+				}
+			}
+			annotations = append(annotations, a)
+		}
+	}
+	return annotations
+}
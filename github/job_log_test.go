@@ -0,0 +1,79 @@
+package github
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/skanehira/ght/domain"
+)
+
+func TestParseLogSections(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []*domain.LogSection
+	}{
+		{
+			name: "empty log",
+			text: "",
+			want: nil,
+		},
+		{
+			name: "plain lines",
+			text: "a\nb\n",
+			want: []*domain.LogSection{
+				{Lines: []string{"a", "b"}},
+			},
+		},
+		{
+			name: "groups between plain lines",
+			text: "##[group]Run make\nmake\n##[endgroup]\nbuilding\n##[group]Env\nGOOS=linux\n##[endgroup]\n",
+			want: []*domain.LogSection{
+				{Title: "Run make", Lines: []string{"make"}, IsGroup: true, Collapsed: true},
+				{Lines: []string{"building"}},
+				{Title: "Env", Lines: []string{"GOOS=linux"}, IsGroup: true, Collapsed: true},
+			},
+		},
+		{
+			name: "unterminated group",
+			text: "##[group]Run tests\nok",
+			want: []*domain.LogSection{
+				{Title: "Run tests", Lines: []string{"ok"}, IsGroup: true, Collapsed: true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseLogSections(tt.text)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseLogSections() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseLogAnnotations(t *testing.T) {
+	text := "Run go vet ./...\n" +
+		"##[error]Process completed with exit code 1.\n" +
+		"  ##[warning]Node.js 16 actions are deprecated.\n" +
+		"::error file=ui/actions.go,line=42,col=3,title=vet::unreachable code\n" +
+		"::notice::done\n" +
+		"echo ::error is not a command here\n"
+
+	want := []*domain.Annotation{
+		{Level: "error", Message: "Process completed with exit code 1.", LogLine: 1},
+		{Level: "warning", Message: "Node.js 16 actions are deprecated.", LogLine: 2},
+		{Level: "error", Path: "ui/actions.go", Line: 42, Title: "vet", Message: "unreachable code", LogLine: 3},
+		{Level: "notice", Message: "done", LogLine: 4},
+	}
+
+	got := ParseLogAnnotations(text)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseLogAnnotations() =\n%+v\nwant\n%+v", got, want)
+	}
+
+	if got := want[2].Location(); got != "ui/actions.go:42" {
+		t.Errorf("Location() = %q, want %q", got, "ui/actions.go:42")
+	}
+}
@@ -0,0 +1,356 @@
+package github
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/skanehira/ght/domain"
+)
+
+const (
+	// logCacheTTL is how long downloaded job logs are kept in the cache.
+	logCacheTTL = 7 * 24 * time.Hour
+
+	// logTimesSuffix is appended to the path of a cached log for the file
+	// with the timestamps of its lines.
+	logTimesSuffix = ".times"
+)
+
+// lineTime is the timestamp of a line of a job log. Steps start on whole
+// seconds, so only the lines at which the second changes are kept.
+type lineTime struct {
+	line int
+	at   time.Time
+}
+
+// LogStore is a cleaned job log cached on disk. Its lines are read on demand,
+// so logs of any size can be paged through and searched without holding them
+// in memory.
+type LogStore struct {
+	file *os.File
+
+	// offsets[i] is where line i starts; the last offset is the file size.
+	offsets []int64
+
+	// annotated are the lines that are errors, warnings or notices.
+	annotated []int
+
+	// times are the timestamps the log was cleaned of.
+	times []lineTime
+}
+
+// OpenJobLog downloads the full log of a completed workflow job to the cache,
+// unless it is cached already, and opens it.
+func OpenJobLog(ctx context.Context, owner, repo string, jobID int64) (*LogStore, error) {
+	dir, err := logCacheDir(owner, repo)
+	if err != nil {
+		return nil, err
+	}
+	pruneLogCache(dir)
+
+	// logs of completed jobs do not change, a re-run gets a new job ID
+	path := filepath.Join(dir, fmt.Sprintf("%d.log", jobID))
+	_, err = os.Stat(path)
+	if err == nil {
+		// logs cached before their timestamps were kept are downloaded again
+		_, err = os.Stat(path + logTimesSuffix)
+	}
+	if err != nil {
+		if err := downloadJobLog(ctx, owner, repo, jobID, path); err != nil {
+			return nil, err
+		}
+	}
+
+	return NewLogStore(path)
+}
+
+// NewLogStore opens a cleaned log file and indexes its lines. The timestamps
+// of the lines are read from the file next to it, if there is one.
+func NewLogStore(path string) (*LogStore, error) {
+	times, err := readLogTimes(path + logTimesSuffix)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open log: %w", err)
+	}
+
+	s := &LogStore{file: f, offsets: []int64{0}, times: times}
+	r := bufio.NewReader(f)
+	var offset int64
+	for i := 0; ; i++ {
+		line, err := r.ReadString('\n')
+		if line != "" {
+			offset += int64(len(line))
+			s.offsets = append(s.offsets, offset)
+			s.indexLine(i, line)
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			f.Close()
+			return nil, fmt.Errorf("failed to read log: %w", err)
+		}
+	}
+
+	return s, nil
+}
+
+func (s *LogStore) indexLine(index int, line string) {
+	// most lines are neither, so skip the regular expressions for them
+	if !strings.Contains(line, "##[") && !strings.Contains(line, "::") {
+		return
+	}
+	if LogLineLevel(strings.TrimRight(line, "\r\n")) != "" {
+		s.annotated = append(s.annotated, index)
+	}
+}
+
+// Close closes the log file. The log stays in the cache.
+func (s *LogStore) Close() error {
+	return s.file.Close()
+}
+
+// Len returns the number of lines of the log.
+func (s *LogStore) Len() int {
+	return len(s.offsets) - 1
+}
+
+// Lines returns up to n lines starting at line start.
+func (s *LogStore) Lines(start, n int) ([]string, error) {
+	if start < 0 {
+		start = 0
+	}
+	end := start + n
+	if end > s.Len() {
+		end = s.Len()
+	}
+	if start >= end {
+		return nil, nil
+	}
+
+	buf := make([]byte, s.offsets[end]-s.offsets[start])
+	if _, err := s.file.ReadAt(buf, s.offsets[start]); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("failed to read log: %w", err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(string(buf), "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSuffix(line, "\r")
+	}
+	return lines, nil
+}
+
+// Annotations returns the errors, warnings and notices of the log.
+func (s *LogStore) Annotations() ([]*domain.Annotation, error) {
+	var annotations []*domain.Annotation
+	for _, index := range s.annotated {
+		lines, err := s.Lines(index, 1)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range ParseLogAnnotations(strings.Join(lines, "\n")) {
+			a.LogLine = index
+			annotations = append(annotations, a)
+		}
+	}
+	return annotations, nil
+}
+
+// Search returns the indexes of the lines that contain query.
+func (s *LogStore) Search(query string) ([]int, error) {
+	if query == "" {
+		return nil, nil
+	}
+
+	var hits []int
+	r := bufio.NewReader(io.NewSectionReader(s.file, 0, s.offsets[s.Len()]))
+	for i := 0; ; i++ {
+		line, err := r.ReadString('\n')
+		if strings.Contains(line, query) {
+			hits = append(hits, i)
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to search log: %w", err)
+		}
+	}
+	return hits, nil
+}
+
+// StepStarts returns the line at which the log of every step starts, in the
+// order of steps, followed by the number of lines, so that the log of step i
+// is lines StepStarts[i] to StepStarts[i+1]. Lines belong to the last step that
+// started at or before their timestamp; without timestamps the whole log
+// belongs to the first step.
+func (s *LogStore) StepStarts(steps []*domain.WorkflowStep) []int {
+	if len(steps) == 0 {
+		return nil
+	}
+
+	starts := make([]int, len(steps)+1)
+	cur := 0
+	for _, t := range s.times {
+		next := stepAt(steps, cur, t.at)
+		for i := cur + 1; i <= next; i++ {
+			starts[i] = t.line
+		}
+		cur = next
+	}
+	for i := cur + 1; i < len(starts); i++ {
+		starts[i] = s.Len()
+	}
+	return starts
+}
+
+// downloadJobLog downloads the log of a workflow job to path, cleaning it on the way.
+func downloadJobLog(ctx context.Context, owner, repo string, jobID int64, path string) error {
+	body, err := getWorkflowJobLogBody(ctx, owner, repo, jobID)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	// write to a temporary file first, so an aborted download is not cached
+	tmp, err := os.CreateTemp(filepath.Dir(path), "*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create log file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	w := bufio.NewWriter(tmp)
+	times, err := writeCleanLog(w, body)
+	if err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to download log: %w", err)
+	}
+	if err := w.Flush(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write log file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write log file: %w", err)
+	}
+
+	// the times are written first, so a cached log always has them
+	if err := writeLogTimes(path+logTimesSuffix, times); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to write log file: %w", err)
+	}
+	return nil
+}
+
+// writeCleanLog copies a raw log from r to w line by line, cleaning every
+// line, and returns the timestamps the lines were cleaned of.
+func writeCleanLog(w io.Writer, r io.Reader) ([]lineTime, error) {
+	var times []lineTime
+	br := bufio.NewReader(r)
+	for i := 0; ; i++ {
+		line, err := br.ReadString('\n')
+		if line != "" {
+			if m := lineTimestampRegex.FindStringSubmatch(line); m != nil {
+				t, perr := time.Parse(time.RFC3339Nano, m[1])
+				if perr == nil {
+					t = t.Truncate(time.Second)
+					if len(times) == 0 || !times[len(times)-1].at.Equal(t) {
+						times = append(times, lineTime{line: i, at: t})
+					}
+				}
+			}
+			if _, werr := io.WriteString(w, CleanLog(line)); werr != nil {
+				return nil, werr
+			}
+		}
+		if err == io.EOF {
+			return times, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// writeLogTimes writes the timestamps of a log to path, one "line unix-seconds"
+// pair per line.
+func writeLogTimes(path string, times []lineTime) error {
+	var b strings.Builder
+	for _, t := range times {
+		fmt.Fprintf(&b, "%d %d\n", t.line, t.at.Unix())
+	}
+	if err := os.WriteFile(path, []byte(b.String()), 0600); err != nil {
+		return fmt.Errorf("failed to write log times: %w", err)
+	}
+	return nil
+}
+
+// readLogTimes reads the timestamps written by writeLogTimes. A missing file
+// means the log has no timestamps.
+func readLogTimes(path string) ([]lineTime, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read log times: %w", err)
+	}
+
+	var times []lineTime
+	for _, entry := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		fields := strings.Fields(entry)
+		if len(fields) != 2 {
+			continue
+		}
+		line, err := strconv.Atoi(fields[0])
+		if err != nil {
+			continue
+		}
+		sec, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		times = append(times, lineTime{line: line, at: time.Unix(sec, 0).UTC()})
+	}
+	return times, nil
+}
+
+func logCacheDir(owner, repo string) (string, error) {
+	cache, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to find cache directory: %w", err)
+	}
+
+	dir := filepath.Join(cache, "ght", "logs", owner, repo)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", fmt.Errorf("failed to create log cache: %w", err)
+	}
+	return dir, nil
+}
+
+// pruneLogCache removes the logs in dir that are older than logCacheTTL.
+func pruneLogCache(dir string) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return
+	}
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil || time.Since(info.ModTime()) < logCacheTTL {
+			continue
+		}
+		os.Remove(filepath.Join(dir, entry.Name()))
+	}
+}
@@ -0,0 +1,179 @@
+package github
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/skanehira/ght/domain"
+)
+
+func newTestLogStore(t *testing.T, text string) *LogStore {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "1.log")
+	if err := os.WriteFile(path, []byte(text), 0600); err != nil {
+		t.Fatalf("failed to write log: %v", err)
+	}
+	s, err := NewLogStore(path)
+	if err != nil {
+		t.Fatalf("NewLogStore() error = %v", err)
+	}
+	t.Cleanup(func() { s.Close() })
+	return s
+}
+
+func TestWriteCleanLog(t *testing.T) {
+	raw := "2024-01-15T10:30:45.1234567Z \x1b[32mok\x1b[0m\n2024-01-15T10:30:46.1234567Z ##[error]failed"
+
+	var buf bytes.Buffer
+	times, err := writeCleanLog(&buf, bytes.NewBufferString(raw))
+	if err != nil {
+		t.Fatalf("writeCleanLog() error = %v", err)
+	}
+	if want := "ok\n##[error]failed"; buf.String() != want {
+		t.Errorf("writeCleanLog() = %q, want %q", buf.String(), want)
+	}
+	want := []lineTime{
+		{line: 0, at: time.Date(2024, 1, 15, 10, 30, 45, 0, time.UTC)},
+		{line: 1, at: time.Date(2024, 1, 15, 10, 30, 46, 0, time.UTC)},
+	}
+	if !reflect.DeepEqual(times, want) {
+		t.Errorf("writeCleanLog() times = %v, want %v", times, want)
+	}
+}
+
+func TestLogStoreStepStarts(t *testing.T) {
+	at := func(sec int) time.Time {
+		return time.Date(2024, 1, 15, 10, 0, sec, 0, time.UTC)
+	}
+
+	steps := []*domain.WorkflowStep{
+		{Number: 1, Name: "Set up job", StartedAt: at(0)},
+		{Number: 2, Name: "Checkout", StartedAt: at(2)},
+		{Number: 3, Name: "Lint", StartedAt: at(3), Conclusion: "skipped"},
+		{Number: 4, Name: "Test", StartedAt: at(3)},
+		{Number: 5, Name: "Deploy"},
+	}
+
+	raw := "2024-01-15T10:00:00.1000000Z Current runner version\n" +
+		"2024-01-15T10:00:01.9000000Z Prepare workflow directory\n" +
+		"2024-01-15T10:00:02.0100000Z ##[group]Run actions/checkout@v4\n" +
+		"continued line without timestamp\n" +
+		"2024-01-15T10:00:03.5000000Z ##[group]Run go test ./...\n" +
+		"2024-01-15T10:00:09.0000000Z ok\n"
+
+	path := filepath.Join(t.TempDir(), "1.log")
+	var buf bytes.Buffer
+	times, err := writeCleanLog(&buf, bytes.NewBufferString(raw))
+	if err != nil {
+		t.Fatalf("writeCleanLog() error = %v", err)
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0600); err != nil {
+		t.Fatalf("failed to write log: %v", err)
+	}
+	if err := writeLogTimes(path+logTimesSuffix, times); err != nil {
+		t.Fatalf("writeLogTimes() error = %v", err)
+	}
+
+	s, err := NewLogStore(path)
+	if err != nil {
+		t.Fatalf("NewLogStore() error = %v", err)
+	}
+	defer s.Close()
+
+	got := s.StepStarts(steps)
+	want := []int{0, 2, 4, 4, 6, 6}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("StepStarts() = %v, want %v", got, want)
+	}
+
+	if got := s.StepStarts(nil); got != nil {
+		t.Errorf("StepStarts() without steps = %v, want nil", got)
+	}
+
+	// a log without timestamps belongs to the first step
+	plain := newTestLogStore(t, "one\ntwo\n")
+	if got, want := plain.StepStarts(steps[:2]), []int{0, 2, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("StepStarts() without timestamps = %v, want %v", got, want)
+	}
+}
+
+func TestLogStoreLines(t *testing.T) {
+	s := newTestLogStore(t, "one\r\ntwo\n\nfour\nfive")
+
+	if s.Len() != 5 {
+		t.Fatalf("Len() = %d, want 5", s.Len())
+	}
+
+	tests := []struct {
+		name  string
+		start int
+		n     int
+		want  []string
+	}{
+		{name: "first page", start: 0, n: 2, want: []string{"one", "two"}},
+		{name: "empty line", start: 2, n: 2, want: []string{"", "four"}},
+		{name: "last line without newline", start: 3, n: 10, want: []string{"four", "five"}},
+		{name: "past the end", start: 5, n: 2, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.Lines(tt.start, tt.n)
+			if err != nil {
+				t.Fatalf("Lines() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Lines(%d, %d) = %q, want %q", tt.start, tt.n, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLogStoreAnnotations(t *testing.T) {
+	s := newTestLogStore(t, "build\n##[error]first\n::warning file=a.go,line=3::unused\ntest\n##[error]second\n")
+
+	annotations, err := s.Annotations()
+	if err != nil {
+		t.Fatalf("Annotations() error = %v", err)
+	}
+	if len(annotations) != 3 {
+		t.Fatalf("got %d annotations, want 3", len(annotations))
+	}
+	if a := annotations[2]; a.Level != "error" || a.LogLine != 4 || a.Message != "second" {
+		t.Errorf("annotation = %s %q at line %d, want error \"second\" at line 4", a.Level, a.Message, a.LogLine)
+	}
+	if a := annotations[1]; a.Level != "warning" || a.LogLine != 2 || a.Location() != "a.go:3" {
+		t.Errorf("annotation = %s %s at line %d, want warning a.go:3 at line 2", a.Level, a.Location(), a.LogLine)
+	}
+}
+
+func TestLogStoreSearch(t *testing.T) {
+	s := newTestLogStore(t, "PASS a\nFAIL b\nPASS c\nfail d")
+
+	tests := []struct {
+		query string
+		want  []int
+	}{
+		{query: "PASS", want: []int{0, 2}},
+		{query: "FAIL", want: []int{1}},
+		{query: "missing", want: nil},
+		{query: "", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			got, err := s.Search(tt.query)
+			if err != nil {
+				t.Fatalf("Search() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Search(%q) = %v, want %v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
@@ -39,3 +39,67 @@ type MutateAddIssueComment struct {
 		ClientMutationID githubv4.String
 	} `graphql:"addComment(input: $input)"`
 }
+
+type MutateAddLabelsToLabelable struct {
+	AddLabelsToLabelable struct {
+		ClientMutationID githubv4.String
+	} `graphql:"addLabelsToLabelable(input: $input)"`
+}
+
+type MutateRemoveLabelsFromLabelable struct {
+	RemoveLabelsFromLabelable struct {
+		ClientMutationID githubv4.String
+	} `graphql:"removeLabelsFromLabelable(input: $input)"`
+}
+
+type MutateAddAssigneesToAssignable struct {
+	AddAssigneesToAssignable struct {
+		ClientMutationID githubv4.String
+	} `graphql:"addAssigneesToAssignable(input: $input)"`
+}
+
+type MutateRemoveAssigneesFromAssignable struct {
+	RemoveAssigneesFromAssignable struct {
+		ClientMutationID githubv4.String
+	} `graphql:"removeAssigneesFromAssignable(input: $input)"`
+}
+
+// UpdateIssueInput is an input type of UpdateIssue that, unlike
+// githubv4.UpdateIssueInput, sends a nil MilestoneID to remove the milestone.
+// It must be named after the GraphQL input type.
+type UpdateIssueInput struct {
+	// The ID of the Issue to modify. (Required.)
+	ID githubv4.ID `json:"id"`
+	// The Node ID of the milestone for this issue, or nil to remove it. (Required.)
+	MilestoneID *githubv4.ID `json:"milestoneId"`
+
+	// The title for the issue. (Optional.)
+	Title *githubv4.String `json:"title,omitempty"`
+	// The body for the issue description. (Optional.)
+	Body *githubv4.String `json:"body,omitempty"`
+	// The desired issue state. (Optional.)
+	State *githubv4.IssueState `json:"state,omitempty"`
+
+	// A unique identifier for the client performing the mutation. (Optional.)
+	ClientMutationID *githubv4.String `json:"clientMutationId,omitempty"`
+}
+
+// IssueClosedStateReason is the reason an issue was closed for.
+type IssueClosedStateReason string
+
+const (
+	IssueClosedStateReasonCompleted  IssueClosedStateReason = "COMPLETED"
+	IssueClosedStateReasonNotPlanned IssueClosedStateReason = "NOT_PLANNED"
+)
+
+// CloseIssueInput is an input type of CloseIssue with the reason the issue is
+// closed for, which the githubv4 version we depend on does not have.
+type CloseIssueInput struct {
+	// ID of the issue to be closed. (Required.)
+	IssueID githubv4.ID `json:"issueId"`
+	// The reason the issue is to be closed. (Optional.)
+	StateReason *IssueClosedStateReason `json:"stateReason,omitempty"`
+
+	// A unique identifier for the client performing the mutation. (Optional.)
+	ClientMutationID *githubv4.String `json:"clientMutationId,omitempty"`
+}
@@ -0,0 +1,89 @@
+package github
+
+import "github.com/shurcooL/githubv4"
+
+type MutateAddPullRequestReview struct {
+	AddPullRequestReview struct {
+		PullRequestReview struct {
+			ID githubv4.ID
+		}
+	} `graphql:"addPullRequestReview(input: $input)"`
+}
+
+type MutateMergePullRequest struct {
+	MergePullRequest struct {
+		PullRequest struct {
+			ID githubv4.ID
+		}
+	} `graphql:"mergePullRequest(input: $input)"`
+}
+
+type MutateDeleteRef struct {
+	DeleteRef struct {
+		ClientMutationID githubv4.String
+	} `graphql:"deleteRef(input: $input)"`
+}
+
+type MutateCreatePullRequest struct {
+	CreatePullRequest struct {
+		PullRequest struct {
+			ID githubv4.ID
+		}
+	} `graphql:"createPullRequest(input: $input)"`
+}
+
+type MutateUpdatePullRequest struct {
+	UpdatePullRequest struct {
+		PullRequest struct {
+			ID githubv4.ID
+		}
+	} `graphql:"updatePullRequest(input: $input)"`
+}
+
+type MutateRequestReviews struct {
+	RequestReviews struct {
+		ClientMutationID githubv4.String
+	} `graphql:"requestReviews(input: $input)"`
+}
+
+type MutateClosePullRequest struct {
+	ClosePullRequest struct {
+		PullRequest struct {
+			ID githubv4.ID
+		}
+	} `graphql:"closePullRequest(input: $input)"`
+}
+
+type MutateReopenPullRequest struct {
+	ReopenPullRequest struct {
+		PullRequest struct {
+			ID githubv4.ID
+		}
+	} `graphql:"reopenPullRequest(input: $input)"`
+}
+
+type MutateMarkPullRequestReadyForReview struct {
+	MarkPullRequestReadyForReview struct {
+		PullRequest struct {
+			ID githubv4.ID
+		}
+	} `graphql:"markPullRequestReadyForReview(input: $input)"`
+}
+
+// ConvertPullRequestToDraftInput is an input type of ConvertPullRequestToDraft.
+// It is not generated in the githubv4 version we depend on.
+type ConvertPullRequestToDraftInput struct {
+	// ID of the pull request to convert to draft. (Required.)
+	PullRequestID githubv4.ID `json:"pullRequestId"`
+
+	// A unique identifier for the client performing the mutation. (Optional.)
+	ClientMutationID *githubv4.String `json:"clientMutationId,omitempty"`
+}
+
+type MutateConvertPullRequestToDraft struct {
+	ConvertPullRequestToDraft struct {
+		PullRequest struct {
+			ID githubv4.ID
+		}
+	} `graphql:"convertPullRequestToDraft(input: $input)"`
+}
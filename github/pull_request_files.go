@@ -0,0 +1,48 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	gogithub "github.com/google/go-github/v68/github"
+
+	"github.com/skanehira/ght/domain"
+)
+
+// ConvertPullRequestFile converts a go-github CommitFile to a domain PullRequestFile.
+func ConvertPullRequestFile(file *gogithub.CommitFile) *domain.PullRequestFile {
+	return &domain.PullRequestFile{
+		Filename:  file.GetFilename(),
+		Status:    file.GetStatus(),
+		Additions: file.GetAdditions(),
+		Deletions: file.GetDeletions(),
+		Patch:     file.GetPatch(),
+		BlobURL:   file.GetBlobURL(),
+	}
+}
+
+// ListPullRequestFiles lists all files changed in a pull request with full pagination.
+func ListPullRequestFiles(ctx context.Context, owner, repo string, number int) ([]*gogithub.CommitFile, error) {
+	client := GetRESTClient()
+	if client == nil {
+		return nil, fmt.Errorf("REST client not initialized")
+	}
+
+	var allFiles []*gogithub.CommitFile
+	opts := &gogithub.ListOptions{PerPage: 100}
+
+	for {
+		files, resp, err := client.PullRequests.ListFiles(ctx, owner, repo, number, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list files for pull request %d: %w", number, err)
+		}
+		allFiles = append(allFiles, files...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return allFiles, nil
+}
@@ -6,11 +6,14 @@ import (
 )
 
 type AssignableUser struct {
+	ID    githubv4.ID
 	Login githubv4.String
 }
 
 func (a *AssignableUser) ToDomain() *domain.AssignableUser {
+	id, _ := a.ID.(string)
 	assignableUser := &domain.AssignableUser{
+		ID:    id,
 		Login: string(a.Login),
 	}
 	return assignableUser
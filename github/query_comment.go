@@ -25,3 +25,30 @@ func (c *Comment) ToDomain() *domain.Comment {
 	}
 	return comment
 }
+
+// Comments is a page of the comments of an issue.
+type Comments struct {
+	TotalCount githubv4.Int
+	Nodes      []Comment
+	PageInfo   struct {
+		StartCursor     githubv4.String
+		EndCursor       githubv4.String
+		HasPreviousPage githubv4.Boolean
+		HasNextPage     githubv4.Boolean
+	}
+}
+
+func (c *Comments) ToDomain() ([]domain.Item, domain.CommentsPage) {
+	comments := make([]domain.Item, len(c.Nodes))
+	for i, comment := range c.Nodes {
+		comments[i] = comment.ToDomain()
+	}
+	page := domain.CommentsPage{
+		TotalCount:  int(c.TotalCount),
+		StartCursor: string(c.PageInfo.StartCursor),
+		EndCursor:   string(c.PageInfo.EndCursor),
+		HasPrevious: bool(c.PageInfo.HasPreviousPage),
+		HasNext:     bool(c.PageInfo.HasNextPage),
+	}
+	return comments, page
+}
@@ -0,0 +1,43 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/skanehira/ght/domain"
+)
+
+func TestCommentsToDomain(t *testing.T) {
+	comments := &Comments{
+		TotalCount: 250,
+		Nodes: []Comment{
+			{ID: "IC_1", Body: "first", URL: mustURI(t, "https://github.com/org/repo/issues/1#issuecomment-1")},
+			{ID: "IC_2", Body: "second", URL: mustURI(t, "https://github.com/org/repo/issues/1#issuecomment-2")},
+		},
+	}
+	comments.PageInfo.StartCursor = "Y3Vyc29yOjE="
+	comments.PageInfo.EndCursor = "Y3Vyc29yOjI="
+	comments.PageInfo.HasPreviousPage = true
+	comments.PageInfo.HasNextPage = true
+
+	items, page := comments.ToDomain()
+
+	if len(items) != 2 {
+		t.Fatalf("len(items) = %d, want 2", len(items))
+	}
+	for i, want := range []string{"IC_1", "IC_2"} {
+		if got := items[i].(*domain.Comment).ID; got != want {
+			t.Errorf("items[%d].ID = %q, want %q", i, got, want)
+		}
+	}
+
+	want := domain.CommentsPage{
+		TotalCount:  250,
+		StartCursor: "Y3Vyc29yOjE=",
+		EndCursor:   "Y3Vyc29yOjI=",
+		HasPrevious: true,
+		HasNext:     true,
+	}
+	if page != want {
+		t.Errorf("page = %+v, want %+v", page, want)
+	}
+}
@@ -17,10 +17,11 @@ type Issue struct {
 		}
 		Name githubv4.String
 	}
-	Number githubv4.Int
-	Body   githubv4.String
-	State  githubv4.String
-	Author struct {
+	Number      githubv4.Int
+	Body        githubv4.String
+	State       githubv4.String
+	StateReason githubv4.String
+	Author      struct {
 		Login githubv4.String
 	}
 	Title     githubv4.String
@@ -29,28 +30,25 @@ type Issue struct {
 	Assignees struct {
 		Nodes []AssignableUser
 	} `graphql:"assignees(first: 10)"`
-	ProjectCards struct {
-		Nodes []struct {
-			Project Project
-		}
-	} `graphql:"projectCards(first: 10)"`
-	Milestone Milestone
-	Comments  struct {
-		Nodes []Comment
-	} `graphql:"comments(first: 100)"`
+	ProjectCards ProjectCards `graphql:"projectCards(first: 10)"`
+	Milestone    Milestone
+	// Comments is the first page of comments, as many as the queries of
+	// issues ask for with $commentsFirst.
+	Comments Comments `graphql:"comments(first: $commentsFirst)"`
 }
 
 func (i *Issue) ToDomain() *domain.Issue {
 	issue := &domain.Issue{
-		ID:        string(i.ID),
-		Repo:      string(i.Repository.Name),
-		RepoOwner: string(i.Repository.Owner.Login),
-		Number:    strconv.Itoa(int(i.Number)),
-		State:     string(i.State),
-		Author:    string(i.Author.Login),
-		URL:       i.URL.String(),
-		Title:     string(i.Title),
-		Body:      string(i.Body),
+		ID:          string(i.ID),
+		Repo:        string(i.Repository.Name),
+		RepoOwner:   string(i.Repository.Owner.Login),
+		Number:      strconv.Itoa(int(i.Number)),
+		State:       string(i.State),
+		StateReason: string(i.StateReason),
+		Author:      string(i.Author.Login),
+		URL:         i.URL.String(),
+		Title:       string(i.Title),
+		Body:        string(i.Body),
 	}
 
 	labels := make([]domain.Item, len(i.Labels.Nodes))
@@ -65,11 +63,7 @@ func (i *Issue) ToDomain() *domain.Issue {
 	}
 	issue.Assignees = assignees
 
-	comments := make([]domain.Item, len(i.Comments.Nodes))
-	for i, comment := range i.Comments.Nodes {
-		comments[i] = comment.ToDomain()
-	}
-	issue.Comments = comments
+	issue.Comments, issue.CommentsPage = i.Comments.ToDomain()
 
 	if !reflect.ValueOf(i.Milestone).IsZero() {
 		issue.MileStone = append(issue.MileStone, i.Milestone.ToDomain())
@@ -13,7 +13,9 @@ type Label struct {
 }
 
 func (l *Label) ToDomain() *domain.Label {
+	id, _ := l.ID.(string)
 	label := &domain.Label{
+		ID:          id,
 		Name:        string(l.Name),
 		Description: string(l.Description),
 	}
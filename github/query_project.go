@@ -12,7 +12,9 @@ type Project struct {
 }
 
 func (p *Project) ToDomain() *domain.Project {
+	id, _ := p.ID.(string)
 	project := &domain.Project{
+		ID:   id,
 		Name: string(p.Name),
 		URL:  p.URL.String(),
 	}
@@ -23,3 +25,10 @@ type Projects struct {
 	Nodes    []Project
 	PageInfo PageInfo
 }
+
+type ProjectCards struct {
+	Nodes []struct {
+		Project Project
+	}
+	PageInfo PageInfo
+}
@@ -0,0 +1,192 @@
+package github
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/skanehira/ght/domain"
+)
+
+type PullRequest struct {
+	ID         githubv4.String
+	Repository struct {
+		ID    githubv4.String
+		Owner struct {
+			Login githubv4.String
+		}
+		Name githubv4.String
+	}
+	Number githubv4.Int
+	Body   githubv4.String
+	State  githubv4.String
+	Author struct {
+		Login githubv4.String
+	}
+	Title          githubv4.String
+	URL            githubv4.URI
+	BaseRefName    githubv4.String
+	HeadRefName    githubv4.String
+	IsDraft        githubv4.Boolean
+	ReviewDecision githubv4.String
+	Mergeable      githubv4.String
+	Labels         Labels `graphql:"labels(first: 10)"`
+	Assignees      struct {
+		Nodes []AssignableUser
+	} `graphql:"assignees(first: 10)"`
+}
+
+func (p *PullRequest) ToDomain() *domain.PullRequest {
+	pr := &domain.PullRequest{
+		ID:             string(p.ID),
+		Repo:           string(p.Repository.Name),
+		RepoOwner:      string(p.Repository.Owner.Login),
+		Number:         strconv.Itoa(int(p.Number)),
+		State:          string(p.State),
+		Author:         string(p.Author.Login),
+		URL:            p.URL.String(),
+		Title:          string(p.Title),
+		Body:           string(p.Body),
+		BaseRef:        string(p.BaseRefName),
+		HeadRef:        string(p.HeadRefName),
+		IsDraft:        bool(p.IsDraft),
+		ReviewDecision: string(p.ReviewDecision),
+		Mergeable:      string(p.Mergeable),
+	}
+
+	labels := make([]domain.Item, len(p.Labels.Nodes))
+	for i, label := range p.Labels.Nodes {
+		labels[i] = label.ToDomain()
+	}
+	pr.Labels = labels
+
+	assignees := make([]domain.Item, len(p.Assignees.Nodes))
+	for i, a := range p.Assignees.Nodes {
+		assignees[i] = a.ToDomain()
+	}
+	pr.Assignees = assignees
+
+	return pr
+}
+
+type PullRequests struct {
+	Nodes []struct {
+		PullRequest PullRequest `graphql:"... on PullRequest"`
+	}
+	PageInfo PageInfo
+}
+
+// StatusCheckContext is a check run or a commit status in a status check rollup.
+type StatusCheckContext struct {
+	Typename githubv4.String `graphql:"__typename"`
+	CheckRun struct {
+		ID          githubv4.ID
+		Name        githubv4.String
+		Status      githubv4.String
+		Conclusion  githubv4.String
+		IsRequired  githubv4.Boolean `graphql:"isRequired(pullRequestNumber: $number)"`
+		DatabaseID  githubv4.Int     `graphql:"databaseId"`
+		StartedAt   *githubv4.DateTime
+		CompletedAt *githubv4.DateTime
+		DetailsURL  *githubv4.URI `graphql:"detailsUrl"`
+		CheckSuite  struct {
+			WorkflowRun *struct {
+				DatabaseID githubv4.Int `graphql:"databaseId"`
+				RunNumber  githubv4.Int
+				Workflow   struct {
+					Name githubv4.String
+				}
+			}
+		}
+	} `graphql:"... on CheckRun"`
+	StatusContext struct {
+		ID         githubv4.ID
+		Context    githubv4.String
+		State      githubv4.String
+		IsRequired githubv4.Boolean `graphql:"isRequired(pullRequestNumber: $number)"`
+		TargetURL  *githubv4.URI    `graphql:"targetUrl"`
+	} `graphql:"... on StatusContext"`
+}
+
+func (s *StatusCheckContext) ToDomain() *domain.StatusCheck {
+	if s.Typename == "StatusContext" {
+		id, _ := s.StatusContext.ID.(string)
+		check := &domain.StatusCheck{
+			ID:         id,
+			Name:       string(s.StatusContext.Context),
+			State:      string(s.StatusContext.State),
+			IsRequired: bool(s.StatusContext.IsRequired),
+		}
+		if s.StatusContext.TargetURL != nil {
+			check.URL = s.StatusContext.TargetURL.String()
+		}
+		return check
+	}
+
+	run := s.CheckRun
+
+	// a check run has no conclusion until it is completed
+	state := string(run.Conclusion)
+	if state == "" {
+		state = string(run.Status)
+	}
+	id, _ := run.ID.(string)
+	check := &domain.StatusCheck{
+		ID:         id,
+		Name:       string(run.Name),
+		State:      state,
+		IsRequired: bool(run.IsRequired),
+	}
+	if run.DetailsURL != nil {
+		check.URL = run.DetailsURL.String()
+	}
+	if run.StartedAt != nil && run.CompletedAt != nil {
+		check.Duration = formatDuration(run.CompletedAt.Sub(run.StartedAt.Time))
+	}
+
+	// check runs of GitHub Actions are jobs of the workflow run of their check suite
+	if wr := run.CheckSuite.WorkflowRun; wr != nil {
+		check.RunID = int64(wr.DatabaseID)
+		check.RunName = fmt.Sprintf("#%d - %s", wr.RunNumber, wr.Workflow.Name)
+		check.JobID = int64(run.DatabaseID)
+	}
+	return check
+}
+
+// PullRequestMergeStatus is the state of a pull request that decides whether it can be merged.
+type PullRequestMergeStatus struct {
+	ID               githubv4.ID
+	Title            githubv4.String
+	Number           githubv4.Int
+	Mergeable        githubv4.String
+	MergeStateStatus githubv4.String
+	ReviewDecision   githubv4.String
+	HeadRefOid       githubv4.GitObjectID
+	HeadRef          *struct {
+		ID githubv4.ID
+	}
+	Commits struct {
+		Nodes []struct {
+			Commit struct {
+				StatusCheckRollup *struct {
+					State    githubv4.String
+					Contexts struct {
+						Nodes []StatusCheckContext
+					} `graphql:"contexts(first: 100)"`
+				}
+			}
+		}
+	} `graphql:"commits(last: 1)"`
+}
+
+// StatusChecks returns the check runs and commit statuses on the head commit.
+func (m *PullRequestMergeStatus) StatusChecks() (state string, checks []*domain.StatusCheck) {
+	if len(m.Commits.Nodes) == 0 || m.Commits.Nodes[0].Commit.StatusCheckRollup == nil {
+		return "", nil
+	}
+	rollup := m.Commits.Nodes[0].Commit.StatusCheckRollup
+	for _, c := range rollup.Contexts.Nodes {
+		checks = append(checks, c.ToDomain())
+	}
+	return string(rollup.State), checks
+}
@@ -0,0 +1,168 @@
+package github
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/shurcooL/githubv4"
+)
+
+func TestPullRequestToDomain(t *testing.T) {
+	u, err := url.Parse("https://github.com/org/repo/pull/42")
+	if err != nil {
+		t.Fatalf("failed to parse url: %v", err)
+	}
+
+	pr := &PullRequest{
+		ID:             "PR_kwDOAAAB",
+		Number:         42,
+		State:          "OPEN",
+		Title:          "feat: add pull request list",
+		Body:           "body",
+		URL:            githubv4.URI{URL: u},
+		BaseRefName:    "main",
+		HeadRefName:    "feature",
+		IsDraft:        true,
+		ReviewDecision: "CHANGES_REQUESTED",
+		Mergeable:      "CONFLICTING",
+	}
+	pr.Repository.Name = "repo"
+	pr.Repository.Owner.Login = "org"
+	pr.Author.Login = "octocat"
+	pr.Labels.Nodes = []Label{{Name: "bug"}}
+	pr.Assignees.Nodes = []AssignableUser{{Login: "hubot"}}
+
+	got := pr.ToDomain()
+
+	if got.Number != "42" {
+		t.Errorf("Number = %q, want %q", got.Number, "42")
+	}
+	if got.RepoOwner != "org" || got.Repo != "repo" {
+		t.Errorf("repo = %s/%s, want org/repo", got.RepoOwner, got.Repo)
+	}
+	if got.BaseRef != "main" || got.HeadRef != "feature" {
+		t.Errorf("branches = %s <- %s, want main <- feature", got.BaseRef, got.HeadRef)
+	}
+	if !got.IsDraft {
+		t.Error("IsDraft = false, want true")
+	}
+	if got.ReviewDecision != "CHANGES_REQUESTED" {
+		t.Errorf("ReviewDecision = %q, want %q", got.ReviewDecision, "CHANGES_REQUESTED")
+	}
+	if got.Mergeable != "CONFLICTING" {
+		t.Errorf("Mergeable = %q, want %q", got.Mergeable, "CONFLICTING")
+	}
+	if got.URL != "https://github.com/org/repo/pull/42" {
+		t.Errorf("URL = %q, want %q", got.URL, "https://github.com/org/repo/pull/42")
+	}
+	if len(got.Labels) != 1 || got.Labels[0].Key() != "bug" {
+		t.Errorf("Labels = %v, want [bug]", got.Labels)
+	}
+	if len(got.Assignees) != 1 || got.Assignees[0].Key() != "hubot" {
+		t.Errorf("Assignees = %v, want [hubot]", got.Assignees)
+	}
+
+	fields := got.Fields()
+	if len(fields) != 8 {
+		t.Fatalf("Fields() returned %d fields, want 8", len(fields))
+	}
+	if fields[3].Text != "main <- feature" {
+		t.Errorf("branch field = %q, want %q", fields[3].Text, "main <- feature")
+	}
+	if fields[4].Text != "draft" {
+		t.Errorf("draft field = %q, want %q", fields[4].Text, "draft")
+	}
+}
+
+func TestStatusCheckContextToDomain(t *testing.T) {
+	started := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	completed := started.Add(90 * time.Second)
+
+	actions := StatusCheckContext{Typename: "CheckRun"}
+	actions.CheckRun.Name = "test"
+	actions.CheckRun.Status = "COMPLETED"
+	actions.CheckRun.Conclusion = "FAILURE"
+	actions.CheckRun.IsRequired = true
+	actions.CheckRun.DatabaseID = 200
+	actions.CheckRun.StartedAt = &githubv4.DateTime{Time: started}
+	actions.CheckRun.CompletedAt = &githubv4.DateTime{Time: completed}
+	actions.CheckRun.CheckSuite.WorkflowRun = &struct {
+		DatabaseID githubv4.Int `graphql:"databaseId"`
+		RunNumber  githubv4.Int
+		Workflow   struct {
+			Name githubv4.String
+		}
+	}{DatabaseID: 100, RunNumber: 7}
+	actions.CheckRun.CheckSuite.WorkflowRun.Workflow.Name = "CI"
+
+	external := StatusCheckContext{Typename: "CheckRun"}
+	external.CheckRun.Name = "codecov"
+	external.CheckRun.Status = "IN_PROGRESS"
+	external.CheckRun.DatabaseID = 300
+
+	status := StatusCheckContext{Typename: "StatusContext"}
+	status.StatusContext.Context = "ci/circleci"
+	status.StatusContext.State = "PENDING"
+
+	tests := []struct {
+		name        string
+		context     StatusCheckContext
+		wantState   string
+		wantDur     string
+		wantActions bool
+		wantFailing bool
+	}{
+		{"actions job", actions, "FAILURE", "1m 30s", true, true},
+		{"external check run", external, "IN_PROGRESS", "", false, false},
+		{"commit status", status, "PENDING", "", false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.context.ToDomain()
+			if got.State != tt.wantState {
+				t.Errorf("State = %q, want %q", got.State, tt.wantState)
+			}
+			if got.Duration != tt.wantDur {
+				t.Errorf("Duration = %q, want %q", got.Duration, tt.wantDur)
+			}
+			if got.IsActions() != tt.wantActions {
+				t.Errorf("IsActions() = %v, want %v", got.IsActions(), tt.wantActions)
+			}
+			if got.IsFailing() != tt.wantFailing {
+				t.Errorf("IsFailing() = %v, want %v", got.IsFailing(), tt.wantFailing)
+			}
+		})
+	}
+
+	got := actions.ToDomain()
+	if got.RunID != 100 || got.JobID != 200 {
+		t.Errorf("RunID, JobID = %d, %d, want 100, 200", got.RunID, got.JobID)
+	}
+	if got.RunName != "#7 - CI" {
+		t.Errorf("RunName = %q, want %q", got.RunName, "#7 - CI")
+	}
+}
+
+func TestStatusCheckContextToDomainKey(t *testing.T) {
+	// commit statuses without a target URL, reported twice under the same name
+	first := StatusCheckContext{Typename: "StatusContext"}
+	first.StatusContext.ID = "SC_1"
+	first.StatusContext.Context = "deploy"
+	second := StatusCheckContext{Typename: "StatusContext"}
+	second.StatusContext.ID = "SC_2"
+	second.StatusContext.Context = "deploy"
+	run := StatusCheckContext{Typename: "CheckRun"}
+	run.CheckRun.ID = "CR_1"
+	run.CheckRun.Name = "deploy"
+
+	keys := map[string]bool{}
+	for _, c := range []StatusCheckContext{first, second, run} {
+		key := c.ToDomain().Key()
+		if keys[key] {
+			t.Errorf("Key() = %q is not unique", key)
+		}
+		keys[key] = true
+	}
+}
@@ -23,3 +23,13 @@ type Repositories struct {
 	Nodes    []Repository
 	PageInfo PageInfo
 }
+
+type Ref struct {
+	ID   githubv4.ID
+	Name githubv4.String
+}
+
+type Refs struct {
+	Nodes    []Ref
+	PageInfo PageInfo
+}
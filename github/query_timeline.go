@@ -0,0 +1,243 @@
+package github
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/skanehira/ght/domain"
+)
+
+type timelineActor struct {
+	Login githubv4.String
+}
+
+type timelineReference struct {
+	Number     githubv4.Int
+	Title      githubv4.String
+	URL        githubv4.URI
+	Repository struct {
+		NameWithOwner githubv4.String
+	}
+}
+
+func (r *timelineReference) String() string {
+	return fmt.Sprintf("%s#%d %s", r.Repository.NameWithOwner, r.Number, r.Title)
+}
+
+// timelineSubject is an issue or a pull request.
+type timelineSubject struct {
+	Typename    githubv4.String   `graphql:"__typename"`
+	Issue       timelineReference `graphql:"... on Issue"`
+	PullRequest timelineReference `graphql:"... on PullRequest"`
+}
+
+type timelineCommit struct {
+	AbbreviatedOid  githubv4.String
+	MessageHeadline githubv4.String
+	Message         githubv4.String
+	URL             githubv4.URI
+}
+
+// TimelineItem is an item of the timeline of an issue. Only the fragment of
+// its Typename is set.
+type TimelineItem struct {
+	Typename githubv4.String `graphql:"__typename"`
+	Node     struct {
+		ID githubv4.ID
+	} `graphql:"... on Node"`
+	IssueComment struct {
+		Author    timelineActor
+		CreatedAt githubv4.DateTime
+		Body      githubv4.String
+		URL       githubv4.URI
+	} `graphql:"... on IssueComment"`
+	LabeledEvent struct {
+		Actor     timelineActor
+		CreatedAt githubv4.DateTime
+		Label     struct {
+			Name githubv4.String
+		}
+	} `graphql:"... on LabeledEvent"`
+	UnlabeledEvent struct {
+		Actor     timelineActor
+		CreatedAt githubv4.DateTime
+		Label     struct {
+			Name githubv4.String
+		}
+	} `graphql:"... on UnlabeledEvent"`
+	AssignedEvent struct {
+		Actor     timelineActor
+		CreatedAt githubv4.DateTime
+		Assignee  struct {
+			Actor timelineActor `graphql:"... on Actor"`
+		}
+	} `graphql:"... on AssignedEvent"`
+	UnassignedEvent struct {
+		Actor     timelineActor
+		CreatedAt githubv4.DateTime
+		Assignee  struct {
+			Actor timelineActor `graphql:"... on Actor"`
+		}
+	} `graphql:"... on UnassignedEvent"`
+	ClosedEvent struct {
+		Actor       timelineActor
+		CreatedAt   githubv4.DateTime
+		StateReason githubv4.String
+		Closer      struct {
+			PullRequest timelineReference `graphql:"... on PullRequest"`
+			Commit      timelineCommit    `graphql:"... on Commit"`
+		}
+	} `graphql:"... on ClosedEvent"`
+	ReopenedEvent struct {
+		Actor     timelineActor
+		CreatedAt githubv4.DateTime
+	} `graphql:"... on ReopenedEvent"`
+	RenamedTitleEvent struct {
+		Actor         timelineActor
+		CreatedAt     githubv4.DateTime
+		PreviousTitle githubv4.String
+		CurrentTitle  githubv4.String
+	} `graphql:"... on RenamedTitleEvent"`
+	CrossReferencedEvent struct {
+		Actor           timelineActor
+		CreatedAt       githubv4.DateTime
+		WillCloseTarget githubv4.Boolean
+		Source          timelineSubject
+	} `graphql:"... on CrossReferencedEvent"`
+	ConnectedEvent struct {
+		Actor     timelineActor
+		CreatedAt githubv4.DateTime
+		Subject   timelineSubject
+	} `graphql:"... on ConnectedEvent"`
+	ReferencedEvent struct {
+		Actor     timelineActor
+		CreatedAt githubv4.DateTime
+		Commit    timelineCommit
+	} `graphql:"... on ReferencedEvent"`
+}
+
+// ToDomain returns the timeline event of the item, or nil if the item is of
+// a type the timeline does not show.
+func (t *TimelineItem) ToDomain() *domain.TimelineEvent {
+	id, _ := t.Node.ID.(string)
+	event := &domain.TimelineEvent{ID: id}
+
+	var actor timelineActor
+	var createdAt githubv4.DateTime
+
+	switch t.Typename {
+	case "IssueComment":
+		c := t.IssueComment
+		actor, createdAt = c.Author, c.CreatedAt
+		event.Event = "commented"
+		event.Summary = firstLine(string(c.Body))
+		event.Body = string(c.Body)
+		event.URL = c.URL.String()
+	case "LabeledEvent":
+		e := t.LabeledEvent
+		actor, createdAt = e.Actor, e.CreatedAt
+		event.Event = "labeled"
+		event.Summary = "added label " + string(e.Label.Name)
+	case "UnlabeledEvent":
+		e := t.UnlabeledEvent
+		actor, createdAt = e.Actor, e.CreatedAt
+		event.Event = "unlabeled"
+		event.Summary = "removed label " + string(e.Label.Name)
+	case "AssignedEvent":
+		e := t.AssignedEvent
+		actor, createdAt = e.Actor, e.CreatedAt
+		event.Event = "assigned"
+		event.Summary = "assigned " + string(e.Assignee.Actor.Login)
+	case "UnassignedEvent":
+		e := t.UnassignedEvent
+		actor, createdAt = e.Actor, e.CreatedAt
+		event.Event = "unassigned"
+		event.Summary = "unassigned " + string(e.Assignee.Actor.Login)
+	case "ClosedEvent":
+		e := t.ClosedEvent
+		actor, createdAt = e.Actor, e.CreatedAt
+		event.Event = "closed"
+		event.Summary = "closed"
+		if e.StateReason != "" {
+			event.Summary += " as " + strings.ToLower(strings.ReplaceAll(string(e.StateReason), "_", " "))
+		}
+		switch {
+		case e.Closer.PullRequest.Number != 0:
+			event.Summary += " by " + e.Closer.PullRequest.String()
+			event.URL = e.Closer.PullRequest.URL.String()
+		case e.Closer.Commit.AbbreviatedOid != "":
+			event.Summary += " in " + string(e.Closer.Commit.AbbreviatedOid)
+			event.Body = string(e.Closer.Commit.Message)
+			event.URL = e.Closer.Commit.URL.String()
+		}
+	case "ReopenedEvent":
+		e := t.ReopenedEvent
+		actor, createdAt = e.Actor, e.CreatedAt
+		event.Event = "reopened"
+		event.Summary = "reopened"
+	case "RenamedTitleEvent":
+		e := t.RenamedTitleEvent
+		actor, createdAt = e.Actor, e.CreatedAt
+		event.Event = "renamed"
+		event.Summary = fmt.Sprintf("%q -> %q", e.PreviousTitle, e.CurrentTitle)
+		event.Body = fmt.Sprintf("from: %s\nto:   %s", e.PreviousTitle, e.CurrentTitle)
+	case "CrossReferencedEvent":
+		e := t.CrossReferencedEvent
+		actor, createdAt = e.Actor, e.CreatedAt
+		event.Event = "referenced"
+		event.Summary = "referenced by " + e.Source.String()
+		if e.WillCloseTarget {
+			event.Summary += " (closes)"
+		}
+		event.URL = e.Source.URL()
+	case "ConnectedEvent":
+		e := t.ConnectedEvent
+		actor, createdAt = e.Actor, e.CreatedAt
+		event.Event = "connected"
+		event.Summary = "linked " + e.Subject.String()
+		event.URL = e.Subject.URL()
+	case "ReferencedEvent":
+		e := t.ReferencedEvent
+		actor, createdAt = e.Actor, e.CreatedAt
+		event.Event = "commit"
+		event.Summary = fmt.Sprintf("%s %s", e.Commit.AbbreviatedOid, e.Commit.MessageHeadline)
+		event.Body = string(e.Commit.Message)
+		event.URL = e.Commit.URL.String()
+	default:
+		return nil
+	}
+
+	event.Actor = string(actor.Login)
+	event.CreatedAt = createdAt.Local().Format("2006/01/02 15:04:05")
+	return event
+}
+
+// String returns the kind, repository, number and title of the subject.
+func (s *timelineSubject) String() string {
+	if s.Typename == "PullRequest" {
+		return "pull request " + s.PullRequest.String()
+	}
+	return "issue " + s.Issue.String()
+}
+
+// URL returns the URL of the issue or the pull request.
+func (s *timelineSubject) URL() string {
+	if s.Typename == "PullRequest" {
+		return s.PullRequest.URL.String()
+	}
+	return s.Issue.URL.String()
+}
+
+func firstLine(s string) string {
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		s = s[:i]
+	}
+	return strings.TrimSpace(s)
+}
+
+type TimelineItems struct {
+	TotalCount githubv4.Int
+	Nodes      []TimelineItem
+	PageInfo   PageInfo
+}
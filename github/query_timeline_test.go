@@ -0,0 +1,168 @@
+package github
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/shurcooL/githubv4"
+)
+
+func mustURI(t *testing.T, s string) githubv4.URI {
+	t.Helper()
+	u, err := url.Parse(s)
+	if err != nil {
+		t.Fatalf("failed to parse url: %v", err)
+	}
+	return githubv4.URI{URL: u}
+}
+
+func TestTimelineItemToDomain(t *testing.T) {
+	tests := []struct {
+		name        string
+		item        func() *TimelineItem
+		wantEvent   string
+		wantActor   string
+		wantSummary string
+		wantBody    string
+		wantURL     string
+	}{
+		{
+			name: "comment",
+			item: func() *TimelineItem {
+				item := &TimelineItem{Typename: "IssueComment"}
+				item.IssueComment.Author.Login = "octocat"
+				item.IssueComment.Body = "Same here.\n\nOn macOS too."
+				item.IssueComment.URL = mustURI(t, "https://github.com/org/repo/issues/1#issuecomment-2")
+				return item
+			},
+			wantEvent:   "commented",
+			wantActor:   "octocat",
+			wantSummary: "Same here.",
+			wantBody:    "Same here.\n\nOn macOS too.",
+			wantURL:     "https://github.com/org/repo/issues/1#issuecomment-2",
+		},
+		{
+			name: "labeled",
+			item: func() *TimelineItem {
+				item := &TimelineItem{Typename: "LabeledEvent"}
+				item.LabeledEvent.Actor.Login = "hubot"
+				item.LabeledEvent.Label.Name = "bug"
+				return item
+			},
+			wantEvent:   "labeled",
+			wantActor:   "hubot",
+			wantSummary: "added label bug",
+		},
+		{
+			name: "assigned",
+			item: func() *TimelineItem {
+				item := &TimelineItem{Typename: "AssignedEvent"}
+				item.AssignedEvent.Actor.Login = "hubot"
+				item.AssignedEvent.Assignee.Actor.Login = "octocat"
+				return item
+			},
+			wantEvent:   "assigned",
+			wantActor:   "hubot",
+			wantSummary: "assigned octocat",
+		},
+		{
+			name: "closed by pull request",
+			item: func() *TimelineItem {
+				item := &TimelineItem{Typename: "ClosedEvent"}
+				item.ClosedEvent.Actor.Login = "octocat"
+				item.ClosedEvent.StateReason = "NOT_PLANNED"
+				pr := &item.ClosedEvent.Closer.PullRequest
+				pr.Number = 3
+				pr.Title = "Remove the feature"
+				pr.URL = mustURI(t, "https://github.com/org/repo/pull/3")
+				pr.Repository.NameWithOwner = "org/repo"
+				return item
+			},
+			wantEvent:   "closed",
+			wantActor:   "octocat",
+			wantSummary: "closed as not planned by org/repo#3 Remove the feature",
+			wantURL:     "https://github.com/org/repo/pull/3",
+		},
+		{
+			name: "renamed",
+			item: func() *TimelineItem {
+				item := &TimelineItem{Typename: "RenamedTitleEvent"}
+				item.RenamedTitleEvent.Actor.Login = "octocat"
+				item.RenamedTitleEvent.PreviousTitle = "Crash on strat"
+				item.RenamedTitleEvent.CurrentTitle = "Crash on start"
+				return item
+			},
+			wantEvent:   "renamed",
+			wantActor:   "octocat",
+			wantSummary: `"Crash on strat" -> "Crash on start"`,
+			wantBody:    "from: Crash on strat\nto:   Crash on start",
+		},
+		{
+			name: "referenced by pull request",
+			item: func() *TimelineItem {
+				item := &TimelineItem{Typename: "CrossReferencedEvent"}
+				item.CrossReferencedEvent.Actor.Login = "octocat"
+				item.CrossReferencedEvent.WillCloseTarget = true
+				source := &item.CrossReferencedEvent.Source
+				source.Typename = "PullRequest"
+				source.PullRequest.Number = 5
+				source.PullRequest.Title = "Fix crash"
+				source.PullRequest.URL = mustURI(t, "https://github.com/org/app/pull/5")
+				source.PullRequest.Repository.NameWithOwner = "org/app"
+				return item
+			},
+			wantEvent:   "referenced",
+			wantActor:   "octocat",
+			wantSummary: "referenced by pull request org/app#5 Fix crash (closes)",
+			wantURL:     "https://github.com/org/app/pull/5",
+		},
+		{
+			name: "commit",
+			item: func() *TimelineItem {
+				item := &TimelineItem{Typename: "ReferencedEvent"}
+				item.ReferencedEvent.Actor.Login = "octocat"
+				item.ReferencedEvent.Commit.AbbreviatedOid = "abc1234"
+				item.ReferencedEvent.Commit.MessageHeadline = "Fix crash"
+				item.ReferencedEvent.Commit.Message = "Fix crash\n\nRefs #1"
+				item.ReferencedEvent.Commit.URL = mustURI(t, "https://github.com/org/repo/commit/abc1234")
+				return item
+			},
+			wantEvent:   "commit",
+			wantActor:   "octocat",
+			wantSummary: "abc1234 Fix crash",
+			wantBody:    "Fix crash\n\nRefs #1",
+			wantURL:     "https://github.com/org/repo/commit/abc1234",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.item().ToDomain()
+			if got == nil {
+				t.Fatal("ToDomain() = nil")
+			}
+			if got.Event != tt.wantEvent {
+				t.Errorf("Event = %q, want %q", got.Event, tt.wantEvent)
+			}
+			if got.Actor != tt.wantActor {
+				t.Errorf("Actor = %q, want %q", got.Actor, tt.wantActor)
+			}
+			if got.Summary != tt.wantSummary {
+				t.Errorf("Summary = %q, want %q", got.Summary, tt.wantSummary)
+			}
+			if got.Body != tt.wantBody {
+				t.Errorf("Body = %q, want %q", got.Body, tt.wantBody)
+			}
+			if got.URL != tt.wantURL {
+				t.Errorf("URL = %q, want %q", got.URL, tt.wantURL)
+			}
+		})
+	}
+}
+
+func TestTimelineItemToDomainUnknown(t *testing.T) {
+	item := &TimelineItem{Typename: "SubscribedEvent"}
+	if got := item.ToDomain(); got != nil {
+		t.Errorf("ToDomain() = %+v, want nil", got)
+	}
+}
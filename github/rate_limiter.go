@@ -53,7 +53,7 @@ func NewRateLimiter() *RateLimiter {
 
 // WrapTransport wraps a base http.RoundTripper with rate limiting middleware.
 // The returned RoundTripper enforces concurrent request limits, per-API-type
-// rate limiting, and parses REST rate limit headers from responses.
+// rate limiting, and parses rate limit headers from responses.
 func (rl *RateLimiter) WrapTransport(base http.RoundTripper) http.RoundTripper {
 	if base == nil {
 		base = http.DefaultTransport
@@ -108,7 +108,7 @@ type rateLimitTransport struct {
 }
 
 // RoundTrip implements http.RoundTripper. It acquires a concurrency slot,
-// waits for the appropriate rate limiter, performs the request, parses REST
+// waits for the appropriate rate limiter, performs the request, parses the
 // rate limit headers from the response, and then releases the slot.
 func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	// Acquire concurrent semaphore slot.
@@ -132,8 +132,10 @@ func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error
 		return nil, err
 	}
 
-	// Parse REST rate limit headers (only for non-GraphQL responses).
-	if !isGraphQLRequest(req) {
+	// Parse the rate limit headers into the state of the API requested.
+	if isGraphQLRequest(req) {
+		t.parseGraphQLHeaders(resp)
+	} else {
 		t.parseRESTHeaders(resp)
 	}
 
@@ -162,3 +164,22 @@ func (t *rateLimitTransport) parseRESTHeaders(resp *http.Response) {
 		}
 	}
 }
+
+// parseGraphQLHeaders extracts the GraphQL rate limit, in points, from the
+// headers GitHub also sends with GraphQL responses and updates the
+// RateLimiter state.
+func (t *rateLimitTransport) parseGraphQLHeaders(resp *http.Response) {
+	t.rl.mu.Lock()
+	defer t.rl.mu.Unlock()
+
+	if v := resp.Header.Get("X-RateLimit-Limit"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			t.rl.graphQLLimit = n
+		}
+	}
+	if v := resp.Header.Get("X-RateLimit-Remaining"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			t.rl.graphQLRemaining = n
+		}
+	}
+}
@@ -178,6 +178,42 @@ func TestRateLimitTransport_GraphQLDoesNotParseRESTHeaders(t *testing.T) {
 	}
 }
 
+func TestRateLimitTransport_ParsesGraphQLHeaders(t *testing.T) {
+	mock := &mockTransport{
+		handler: func(req *http.Request) (*http.Response, error) {
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     make(http.Header),
+			}
+			resp.Header.Set("X-RateLimit-Limit", "5000")
+			resp.Header.Set("X-RateLimit-Remaining", "120")
+			resp.Header.Set("X-RateLimit-Resource", "graphql")
+			return resp, nil
+		},
+	}
+
+	rl := NewRateLimiter()
+	transport := rl.WrapTransport(mock)
+
+	req, err := http.NewRequest(http.MethodPost, "https://api.github.com/graphql", nil)
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+
+	_, err = transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip() error: %v", err)
+	}
+
+	remaining, limit := rl.GetGraphQLStats()
+	if remaining != 120 || limit != 5000 {
+		t.Errorf("GraphQL remaining, limit = %d, %d, want 120, 5000", remaining, limit)
+	}
+	if _, graphql := rl.IsApproachingLimit(0.1); !graphql {
+		t.Error("IsApproachingLimit(0.1) graphql = false, want true")
+	}
+}
+
 func TestRateLimitTransport_ConcurrentSemaphore(t *testing.T) {
 	const totalRequests = 95
 	var inFlight atomic.Int32
@@ -0,0 +1,112 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	gogithub "github.com/google/go-github/v68/github"
+
+	"github.com/skanehira/ght/domain"
+)
+
+// ListWorkflowRunAttempts gets the attempts 1 to latest of a workflow run,
+// newest first.
+func ListWorkflowRunAttempts(ctx context.Context, owner, repo string, runID int64, latest int) ([]*gogithub.WorkflowRun, error) {
+	client := GetRESTClient()
+	if client == nil {
+		return nil, fmt.Errorf("REST client not initialized")
+	}
+
+	var attempts []*gogithub.WorkflowRun
+	for attempt := latest; attempt > 0; attempt-- {
+		run, _, err := client.Actions.GetWorkflowRunAttempt(ctx, owner, repo, runID, attempt, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get attempt %d of run %d: %w", attempt, runID, err)
+		}
+		attempts = append(attempts, run)
+	}
+	return attempts, nil
+}
+
+// ListWorkflowJobsAttempt lists all jobs of an attempt of a workflow run with full pagination.
+func ListWorkflowJobsAttempt(ctx context.Context, owner, repo string, runID int64, attempt int) ([]*gogithub.WorkflowJob, error) {
+	client := GetRESTClient()
+	if client == nil {
+		return nil, fmt.Errorf("REST client not initialized")
+	}
+
+	var allJobs []*gogithub.WorkflowJob
+	opts := &gogithub.ListOptions{PerPage: 100}
+
+	for {
+		jobs, resp, err := client.Actions.ListWorkflowJobsAttempt(ctx, owner, repo, runID, int64(attempt), opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list jobs of attempt %d of run %d: %w", attempt, runID, err)
+		}
+		allJobs = append(allJobs, jobs.Jobs...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return allJobs, nil
+}
+
+// ConvertWorkflowRunAttempt converts a go-github WorkflowRun, as returned for
+// one of its attempts, to a domain WorkflowRunAttempt.
+func ConvertWorkflowRunAttempt(run *gogithub.WorkflowRun) *domain.WorkflowRunAttempt {
+	attempt := &domain.WorkflowRunAttempt{
+		RunID:      run.GetID(),
+		Attempt:    run.GetRunAttempt(),
+		Status:     run.GetStatus(),
+		Conclusion: run.GetConclusion(),
+		Actor:      run.GetTriggeringActor().GetLogin(),
+		HTMLURL:    attemptURL(run.GetHTMLURL(), run.GetRunAttempt()),
+	}
+	if run.RunStartedAt != nil {
+		attempt.StartedAt = formatTime(run.RunStartedAt.Time)
+		attempt.Duration = formatDuration(run.GetUpdatedAt().Time.Sub(run.RunStartedAt.Time))
+	}
+	return attempt
+}
+
+// attemptURL returns the page of an attempt of the run at htmlURL, which may
+// already point to an attempt.
+func attemptURL(htmlURL string, attempt int) string {
+	if i := strings.Index(htmlURL, "/attempts/"); i >= 0 {
+		htmlURL = htmlURL[:i]
+	}
+	return fmt.Sprintf("%s/attempts/%d", htmlURL, attempt)
+}
+
+// CompareJobs pairs the jobs of two attempts of a workflow run by name, in the
+// order they ran in the before attempt followed by jobs only in the after attempt.
+// Jobs sharing a name, like the jobs of a matrix without a name template, are
+// paired in the order they ran.
+func CompareJobs(before, after []*domain.WorkflowJob) []*domain.JobComparison {
+	var comparisons []*domain.JobComparison
+	byKey := map[string]*domain.JobComparison{}
+
+	occurrences := map[string]int{}
+	for _, job := range before {
+		c := &domain.JobComparison{Name: job.Name, Occurrence: occurrences[job.Name], Before: job}
+		occurrences[job.Name]++
+		byKey[c.Key()] = c
+		comparisons = append(comparisons, c)
+	}
+
+	occurrences = map[string]int{}
+	for _, job := range after {
+		c := &domain.JobComparison{Name: job.Name, Occurrence: occurrences[job.Name], After: job}
+		occurrences[job.Name]++
+		if before, ok := byKey[c.Key()]; ok {
+			before.After = job
+			continue
+		}
+		comparisons = append(comparisons, c)
+	}
+	return comparisons
+}
@@ -0,0 +1,147 @@
+package github
+
+import (
+	"testing"
+	"time"
+
+	gogithub "github.com/google/go-github/v68/github"
+
+	"github.com/skanehira/ght/domain"
+)
+
+func TestConvertWorkflowRunAttempt(t *testing.T) {
+	started := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	run := &gogithub.WorkflowRun{
+		ID:              gogithub.Ptr(int64(100)),
+		RunAttempt:      gogithub.Ptr(2),
+		Status:          gogithub.Ptr("completed"),
+		Conclusion:      gogithub.Ptr("success"),
+		TriggeringActor: &gogithub.User{Login: gogithub.Ptr("octocat")},
+		RunStartedAt:    &gogithub.Timestamp{Time: started},
+		UpdatedAt:       &gogithub.Timestamp{Time: started.Add(150 * time.Second)},
+	}
+
+	got := ConvertWorkflowRunAttempt(run)
+	if got.Key() != "100/2" {
+		t.Errorf("Key() = %q, want %q", got.Key(), "100/2")
+	}
+	if got.Actor != "octocat" {
+		t.Errorf("Actor = %q, want %q", got.Actor, "octocat")
+	}
+	if got.Duration != "2m 30s" {
+		t.Errorf("Duration = %q, want %q", got.Duration, "2m 30s")
+	}
+}
+
+func TestCompareJobs(t *testing.T) {
+	job := func(name, conclusion string) *domain.WorkflowJob {
+		return &domain.WorkflowJob{Name: name, Status: "completed", Conclusion: conclusion}
+	}
+
+	before := []*domain.WorkflowJob{job("build", "success"), job("test", "failure"), job("lint", "success")}
+	after := []*domain.WorkflowJob{job("build", "success"), job("test", "success"), job("deploy", "success")}
+
+	tests := []struct {
+		name        string
+		wantBefore  bool
+		wantAfter   bool
+		wantChanged bool
+	}{
+		{name: "build", wantBefore: true, wantAfter: true, wantChanged: false},
+		{name: "test", wantBefore: true, wantAfter: true, wantChanged: true},
+		{name: "lint", wantBefore: true, wantAfter: false, wantChanged: true},
+		{name: "deploy", wantBefore: false, wantAfter: true, wantChanged: true},
+	}
+
+	got := CompareJobs(before, after)
+	if len(got) != len(tests) {
+		t.Fatalf("got %d comparisons, want %d", len(got), len(tests))
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := got[i]
+			if c.Name != tt.name {
+				t.Fatalf("Name = %q, want %q", c.Name, tt.name)
+			}
+			if (c.Before != nil) != tt.wantBefore || (c.After != nil) != tt.wantAfter {
+				t.Errorf("Before, After = %v, %v, want %v, %v", c.Before != nil, c.After != nil, tt.wantBefore, tt.wantAfter)
+			}
+			if c.Changed() != tt.wantChanged {
+				t.Errorf("Changed() = %v, want %v", c.Changed(), tt.wantChanged)
+			}
+		})
+	}
+}
+
+func TestCompareJobsSameName(t *testing.T) {
+	job := func(id int64, conclusion string) *domain.WorkflowJob {
+		return &domain.WorkflowJob{ID: id, Name: "test", Status: "completed", Conclusion: conclusion}
+	}
+
+	before := []*domain.WorkflowJob{job(1, "success"), job(2, "failure")}
+	after := []*domain.WorkflowJob{job(3, "success"), job(4, "success"), job(5, "success")}
+
+	id := func(job *domain.WorkflowJob) int64 {
+		if job == nil {
+			return 0
+		}
+		return job.ID
+	}
+
+	got := CompareJobs(before, after)
+	want := []struct {
+		key           string
+		before, after int64
+	}{
+		{key: "test#0", before: 1, after: 3},
+		{key: "test#1", before: 2, after: 4},
+		{key: "test#2", after: 5},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d comparisons, want %d", len(got), len(want))
+	}
+
+	for i, w := range want {
+		c := got[i]
+		if c.Key() != w.key {
+			t.Errorf("comparisons[%d].Key() = %q, want %q", i, c.Key(), w.key)
+		}
+		if got := id(c.Before); got != w.before {
+			t.Errorf("comparisons[%d].Before.ID = %d, want %d", i, got, w.before)
+		}
+		if got := id(c.After); got != w.after {
+			t.Errorf("comparisons[%d].After.ID = %d, want %d", i, got, w.after)
+		}
+	}
+}
+
+func TestAttemptURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		htmlURL string
+		attempt int
+		want    string
+	}{
+		{
+			name:    "run",
+			htmlURL: "https://github.com/org/repo/actions/runs/100",
+			attempt: 1,
+			want:    "https://github.com/org/repo/actions/runs/100/attempts/1",
+		},
+		{
+			name:    "attempt",
+			htmlURL: "https://github.com/org/repo/actions/runs/100/attempts/2",
+			attempt: 2,
+			want:    "https://github.com/org/repo/actions/runs/100/attempts/2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := attemptURL(tt.htmlURL, tt.attempt); got != tt.want {
+				t.Errorf("attemptURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package github
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	gogithub "github.com/google/go-github/v68/github"
+)
+
+// createdFilterRegex matches the date filters GitHub accepts for the created
+// time of workflow runs, e.g. ">=2024-01-01" or "2024-01-01..2024-01-31".
+var createdFilterRegex = regexp.MustCompile(`^(([<>]=?)?\d{4}-\d{2}-\d{2}|(\d{4}-\d{2}-\d{2}|\*)\.\.(\d{4}-\d{2}-\d{2}|\*))$`)
+
+// ParseRunFilter maps a query of branch:, actor:, event:, status:, created:
+// and sha: tokens onto the options to list workflow runs with.
+func ParseRunFilter(query string) (*gogithub.ListWorkflowRunsOptions, error) {
+	opts := &gogithub.ListWorkflowRunsOptions{}
+	for _, token := range strings.Fields(query) {
+		kv := strings.SplitN(token, ":", 2)
+		if len(kv) != 2 || kv[1] == "" {
+			return nil, fmt.Errorf("invalid filter %q, use key:value", token)
+		}
+
+		key, value := kv[0], kv[1]
+		switch key {
+		case "branch":
+			opts.Branch = value
+		case "actor":
+			opts.Actor = value
+		case "event":
+			opts.Event = value
+		case "status":
+			opts.Status = value
+		case "created":
+			if !createdFilterRegex.MatchString(value) {
+				return nil, fmt.Errorf("invalid date filter %q, use e.g. created:>=2024-01-01 or created:2024-01-01..2024-01-31", value)
+			}
+			opts.Created = value
+		case "sha":
+			opts.HeadSHA = value
+		default:
+			return nil, fmt.Errorf("unknown filter %q, use branch:, actor:, event:, status:, created: or sha:", key)
+		}
+	}
+	return opts, nil
+}
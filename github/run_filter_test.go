@@ -0,0 +1,61 @@
+package github
+
+import (
+	"reflect"
+	"testing"
+
+	gogithub "github.com/google/go-github/v68/github"
+)
+
+func TestParseRunFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		want    *gogithub.ListWorkflowRunsOptions
+		wantErr bool
+	}{
+		{
+			name:  "empty",
+			query: "",
+			want:  &gogithub.ListWorkflowRunsOptions{},
+		},
+		{
+			name:  "all tokens",
+			query: "branch:main actor:octocat event:push status:failure created:>=2026-01-01 sha:abc123",
+			want: &gogithub.ListWorkflowRunsOptions{
+				Branch:  "main",
+				Actor:   "octocat",
+				Event:   "push",
+				Status:  "failure",
+				Created: ">=2026-01-01",
+				HeadSHA: "abc123",
+			},
+		},
+		{
+			name:  "date range",
+			query: "  created:2026-01-01..2026-01-31 ",
+			want:  &gogithub.ListWorkflowRunsOptions{Created: "2026-01-01..2026-01-31"},
+		},
+		{
+			name:  "branch with colon",
+			query: "branch:release:v1",
+			want:  &gogithub.ListWorkflowRunsOptions{Branch: "release:v1"},
+		},
+		{name: "invalid date", query: "created:yesterday", wantErr: true},
+		{name: "unknown key", query: "author:octocat", wantErr: true},
+		{name: "missing value", query: "branch:", wantErr: true},
+		{name: "free text", query: "main", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRunFilter(tt.query)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseRunFilter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseRunFilter() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,295 @@
+package github
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"strings"
+
+	gogithub "github.com/google/go-github/v68/github"
+	"golang.org/x/crypto/nacl/box"
+
+	"github.com/skanehira/ght/domain"
+)
+
+// settingNameRegex matches the names GitHub allows for secrets and variables.
+var settingNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// ListActionsSettings lists the Actions secrets and variables of a repository
+// and of the environments of it.
+func ListActionsSettings(ctx context.Context, owner, repo string, environments []string) ([]*domain.ActionsSetting, error) {
+	client := GetRESTClient()
+	if client == nil {
+		return nil, fmt.Errorf("REST client not initialized")
+	}
+
+	settings, err := listSettings(ctx, client, owner, repo, 0, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(environments) == 0 {
+		return settings, nil
+	}
+
+	repoID, err := getRepositoryID(ctx, client, owner, repo)
+	if err != nil {
+		return nil, err
+	}
+	for _, env := range environments {
+		envSettings, err := listSettings(ctx, client, owner, repo, repoID, env)
+		if err != nil {
+			return nil, err
+		}
+		settings = append(settings, envSettings...)
+	}
+	return settings, nil
+}
+
+// listSettings lists the secrets and variables of the repository, or of the
+// environment env of it if env is not empty.
+func listSettings(ctx context.Context, client *gogithub.Client, owner, repo string, repoID int, env string) ([]*domain.ActionsSetting, error) {
+	var settings []*domain.ActionsSetting
+
+	opts := &gogithub.ListOptions{PerPage: 100}
+	for {
+		var secrets *gogithub.Secrets
+		var resp *gogithub.Response
+		var err error
+		if env == "" {
+			secrets, resp, err = client.Actions.ListRepoSecrets(ctx, owner, repo, opts)
+		} else {
+			secrets, resp, err = client.Actions.ListEnvSecrets(ctx, repoID, env, opts)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list secrets of %s: %w", settingScope(env), err)
+		}
+		for _, secret := range secrets.Secrets {
+			settings = append(settings, ConvertSecret(secret, env))
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	opts = &gogithub.ListOptions{PerPage: 100}
+	for {
+		var variables *gogithub.ActionsVariables
+		var resp *gogithub.Response
+		var err error
+		if env == "" {
+			variables, resp, err = client.Actions.ListRepoVariables(ctx, owner, repo, opts)
+		} else {
+			variables, resp, err = client.Actions.ListEnvVariables(ctx, owner, repo, env, opts)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list variables of %s: %w", settingScope(env), err)
+		}
+		for _, variable := range variables.Variables {
+			settings = append(settings, ConvertVariable(variable, env))
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return settings, nil
+}
+
+// getRepositoryID returns the ID of a repository, which the environment
+// secrets endpoints are addressed by.
+func getRepositoryID(ctx context.Context, client *gogithub.Client, owner, repo string) (int, error) {
+	repository, _, err := client.Repositories.Get(ctx, owner, repo)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get repository %s/%s: %w", owner, repo, err)
+	}
+	return int(repository.GetID()), nil
+}
+
+// ConvertSecret converts a go-github Secret of the environment env, or of the
+// repository if env is empty, to a domain ActionsSetting.
+func ConvertSecret(secret *gogithub.Secret, env string) *domain.ActionsSetting {
+	return &domain.ActionsSetting{
+		Secret:      true,
+		Name:        secret.Name,
+		Environment: env,
+		UpdatedAt:   formatTime(secret.UpdatedAt.Time),
+	}
+}
+
+// ConvertVariable converts a go-github ActionsVariable of the environment env,
+// or of the repository if env is empty, to a domain ActionsSetting.
+func ConvertVariable(variable *gogithub.ActionsVariable, env string) *domain.ActionsSetting {
+	var updated string
+	if variable.UpdatedAt != nil {
+		updated = formatTime(variable.UpdatedAt.Time)
+	}
+
+	return &domain.ActionsSetting{
+		Name:        variable.Name,
+		Value:       variable.Value,
+		Environment: env,
+		UpdatedAt:   updated,
+	}
+}
+
+// ValidateSettingName reports whether name can be used for a secret or variable.
+func ValidateSettingName(name string) error {
+	if !settingNameRegex.MatchString(name) {
+		return fmt.Errorf("name %q may only contain letters, digits and underscores and must not start with a digit", name)
+	}
+	if strings.HasPrefix(strings.ToUpper(name), "GITHUB_") {
+		return fmt.Errorf("name %q must not start with GITHUB_", name)
+	}
+	return nil
+}
+
+// SetSecret creates or updates a secret of the environment env, or of the
+// repository if env is empty. The value is encrypted with the public key of
+// the repository or environment before it is uploaded.
+func SetSecret(ctx context.Context, owner, repo, env, name, value string) error {
+	client := GetRESTClient()
+	if client == nil {
+		return fmt.Errorf("REST client not initialized")
+	}
+
+	var repoID int
+	var key *gogithub.PublicKey
+	var err error
+	if env == "" {
+		key, _, err = client.Actions.GetRepoPublicKey(ctx, owner, repo)
+	} else {
+		if repoID, err = getRepositoryID(ctx, client, owner, repo); err != nil {
+			return err
+		}
+		key, _, err = client.Actions.GetEnvPublicKey(ctx, repoID, env)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get public key of %s: %w", settingScope(env), err)
+	}
+
+	encrypted, err := EncryptSecret(key.GetKey(), value)
+	if err != nil {
+		return err
+	}
+	secret := &gogithub.EncryptedSecret{
+		Name:           name,
+		KeyID:          key.GetKeyID(),
+		EncryptedValue: encrypted,
+	}
+
+	if env == "" {
+		_, err = client.Actions.CreateOrUpdateRepoSecret(ctx, owner, repo, secret)
+	} else {
+		_, err = client.Actions.CreateOrUpdateEnvSecret(ctx, repoID, env, secret)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to set secret %s of %s: %w", name, settingScope(env), err)
+	}
+	return nil
+}
+
+// DeleteSecret deletes a secret of the environment env, or of the repository
+// if env is empty.
+func DeleteSecret(ctx context.Context, owner, repo, env, name string) error {
+	client := GetRESTClient()
+	if client == nil {
+		return fmt.Errorf("REST client not initialized")
+	}
+
+	var err error
+	if env == "" {
+		_, err = client.Actions.DeleteRepoSecret(ctx, owner, repo, name)
+	} else {
+		var repoID int
+		if repoID, err = getRepositoryID(ctx, client, owner, repo); err != nil {
+			return err
+		}
+		_, err = client.Actions.DeleteEnvSecret(ctx, repoID, env, name)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete secret %s of %s: %w", name, settingScope(env), err)
+	}
+	return nil
+}
+
+// SetVariable creates (create true) or updates a variable of the environment
+// env, or of the repository if env is empty.
+func SetVariable(ctx context.Context, owner, repo, env, name, value string, create bool) error {
+	client := GetRESTClient()
+	if client == nil {
+		return fmt.Errorf("REST client not initialized")
+	}
+
+	variable := &gogithub.ActionsVariable{Name: name, Value: value}
+
+	var err error
+	switch {
+	case create && env == "":
+		_, err = client.Actions.CreateRepoVariable(ctx, owner, repo, variable)
+	case create:
+		_, err = client.Actions.CreateEnvVariable(ctx, owner, repo, env, variable)
+	case env == "":
+		_, err = client.Actions.UpdateRepoVariable(ctx, owner, repo, variable)
+	default:
+		_, err = client.Actions.UpdateEnvVariable(ctx, owner, repo, env, variable)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to set variable %s of %s: %w", name, settingScope(env), err)
+	}
+	return nil
+}
+
+// DeleteVariable deletes a variable of the environment env, or of the
+// repository if env is empty.
+func DeleteVariable(ctx context.Context, owner, repo, env, name string) error {
+	client := GetRESTClient()
+	if client == nil {
+		return fmt.Errorf("REST client not initialized")
+	}
+
+	var err error
+	if env == "" {
+		_, err = client.Actions.DeleteRepoVariable(ctx, owner, repo, name)
+	} else {
+		_, err = client.Actions.DeleteEnvVariable(ctx, owner, repo, env, name)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete variable %s of %s: %w", name, settingScope(env), err)
+	}
+	return nil
+}
+
+// EncryptSecret encrypts value with a libsodium sealed box for the base64
+// encoded public key of a repository or environment, as the secrets API
+// requires, and returns the base64 encoded result.
+func EncryptSecret(publicKey, value string) (string, error) {
+	decoded, err := base64.StdEncoding.DecodeString(publicKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode public key: %w", err)
+	}
+	if len(decoded) != 32 {
+		return "", fmt.Errorf("invalid public key length %d", len(decoded))
+	}
+
+	var key [32]byte
+	copy(key[:], decoded)
+	sealed, err := box.SealAnonymous(nil, []byte(value), &key, rand.Reader)
+	if err != nil {
+		return "", fmt.Errorf("failed to encrypt secret: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func settingScope(env string) string {
+	if env == "" {
+		return "repository"
+	}
+	return "environment " + env
+}
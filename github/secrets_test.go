@@ -0,0 +1,103 @@
+package github
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	gogithub "github.com/google/go-github/v68/github"
+	"golang.org/x/crypto/nacl/box"
+)
+
+func TestEncryptSecret(t *testing.T) {
+	publicKey, privateKey, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encrypted, err := EncryptSecret(base64.StdEncoding.EncodeToString(publicKey[:]), "s3cr3t")
+	if err != nil {
+		t.Fatalf("EncryptSecret() error = %v", err)
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		t.Fatalf("encrypted value is not base64: %v", err)
+	}
+	opened, ok := box.OpenAnonymous(nil, sealed, publicKey, privateKey)
+	if !ok {
+		t.Fatal("failed to open sealed box")
+	}
+	if string(opened) != "s3cr3t" {
+		t.Errorf("decrypted = %q, want %q", opened, "s3cr3t")
+	}
+}
+
+func TestEncryptSecretInvalidKey(t *testing.T) {
+	tests := []struct {
+		name string
+		key  string
+	}{
+		{name: "not base64", key: "not base64!"},
+		{name: "wrong length", key: base64.StdEncoding.EncodeToString([]byte("short"))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := EncryptSecret(tt.key, "value"); err == nil {
+				t.Error("EncryptSecret() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestValidateSettingName(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+	}{
+		{name: "upper case", input: "NPM_TOKEN", wantErr: false},
+		{name: "leading underscore", input: "_TOKEN", wantErr: false},
+		{name: "empty", input: "", wantErr: true},
+		{name: "leading digit", input: "1TOKEN", wantErr: true},
+		{name: "dash", input: "NPM-TOKEN", wantErr: true},
+		{name: "github prefix", input: "github_token", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSettingName(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateSettingName(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestConvertSecretAndVariable(t *testing.T) {
+	updated := gogithub.Timestamp{Time: time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)}
+
+	secret := ConvertSecret(&gogithub.Secret{Name: "NPM_TOKEN", UpdatedAt: updated}, "")
+	if !secret.Secret || secret.Name != "NPM_TOKEN" || secret.Value != "" {
+		t.Errorf("secret = %+v, want secret NPM_TOKEN without value", secret)
+	}
+	if secret.Scope() != "repository" {
+		t.Errorf("secret scope = %q, want %q", secret.Scope(), "repository")
+	}
+	if secret.UpdatedAt != "Jan 15 10:00" {
+		t.Errorf("secret UpdatedAt = %q, want %q", secret.UpdatedAt, "Jan 15 10:00")
+	}
+
+	variable := ConvertVariable(&gogithub.ActionsVariable{Name: "REGION", Value: "eu-west-1"}, "production")
+	if variable.Secret || variable.Value != "eu-west-1" || variable.UpdatedAt != "" {
+		t.Errorf("variable = %+v, want variable with value eu-west-1 and no update time", variable)
+	}
+	if variable.Scope() != "production" {
+		t.Errorf("variable scope = %q, want %q", variable.Scope(), "production")
+	}
+	if secret.Key() == ConvertSecret(&gogithub.Secret{Name: "NPM_TOKEN"}, "production").Key() {
+		t.Error("secrets of different scopes have the same key")
+	}
+}
@@ -0,0 +1,101 @@
+package github
+
+import (
+	"fmt"
+
+	"github.com/goccy/go-yaml"
+
+	"github.com/skanehira/ght/domain"
+)
+
+// parseWorkflowFile decodes a workflow file keeping the order of its mappings.
+func parseWorkflowFile(data []byte) (workflow yaml.MapSlice, err error) {
+	// the parser panics on some malformed documents
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to parse workflow file: %v", r)
+		}
+	}()
+
+	if err := yaml.UnmarshalWithOptions(data, &workflow, yaml.UseOrderedMap()); err != nil {
+		return nil, fmt.Errorf("failed to parse workflow file: %w", err)
+	}
+	return workflow, nil
+}
+
+// lookup returns the value of key in a mapping.
+func lookup(m yaml.MapSlice, key string) (interface{}, bool) {
+	for _, item := range m {
+		if k, ok := item.Key.(string); ok && k == key {
+			return item.Value, true
+		}
+	}
+	return nil, false
+}
+
+// workflowTriggers normalizes the "on" section of a workflow, which can be a
+// single event, a list of events or a mapping of events to their configuration.
+func workflowTriggers(on interface{}) yaml.MapSlice {
+	switch on := on.(type) {
+	case string:
+		return yaml.MapSlice{{Key: on}}
+	case []interface{}:
+		triggers := make(yaml.MapSlice, 0, len(on))
+		for _, event := range on {
+			triggers = append(triggers, yaml.MapItem{Key: fmt.Sprint(event)})
+		}
+		return triggers
+	case yaml.MapSlice:
+		return on
+	}
+	return nil
+}
+
+// ParseWorkflowDispatch parses a workflow file and returns the inputs of its
+// workflow_dispatch trigger in declaration order. dispatchable is false when
+// the workflow does not declare workflow_dispatch.
+func ParseWorkflowDispatch(data []byte) (inputs []*domain.WorkflowInput, dispatchable bool, err error) {
+	workflow, err := parseWorkflowFile(data)
+	if err != nil {
+		return nil, false, err
+	}
+
+	on, _ := lookup(workflow, "on")
+	dispatch, ok := lookup(workflowTriggers(on), "workflow_dispatch")
+	if !ok {
+		return nil, false, nil
+	}
+
+	config, _ := dispatch.(yaml.MapSlice)
+	declared, _ := lookup(config, "inputs")
+	declaredInputs, _ := declared.(yaml.MapSlice)
+
+	for _, item := range declaredInputs {
+		spec, _ := item.Value.(yaml.MapSlice)
+		input := &domain.WorkflowInput{
+			Name: fmt.Sprint(item.Key),
+			Type: "string",
+		}
+		if v, ok := lookup(spec, "description"); ok && v != nil {
+			input.Description = fmt.Sprint(v)
+		}
+		if v, ok := lookup(spec, "type"); ok && v != nil {
+			input.Type = fmt.Sprint(v)
+		}
+		if v, ok := lookup(spec, "default"); ok && v != nil {
+			input.Default = fmt.Sprint(v)
+		}
+		if v, ok := lookup(spec, "required"); ok {
+			input.Required, _ = v.(bool)
+		}
+		if v, ok := lookup(spec, "options"); ok {
+			options, _ := v.([]interface{})
+			for _, o := range options {
+				input.Options = append(input.Options, fmt.Sprint(o))
+			}
+		}
+		inputs = append(inputs, input)
+	}
+
+	return inputs, true, nil
+}
@@ -0,0 +1,97 @@
+package github
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/skanehira/ght/domain"
+)
+
+func TestParseWorkflowDispatch(t *testing.T) {
+	tests := []struct {
+		name             string
+		file             string
+		wantDispatchable bool
+		wantInputs       []*domain.WorkflowInput
+	}{
+		{
+			name:             "single event",
+			file:             "on: workflow_dispatch\njobs: {}\n",
+			wantDispatchable: true,
+		},
+		{
+			name:             "list of events",
+			file:             "on: [push, workflow_dispatch]\n",
+			wantDispatchable: true,
+		},
+		{
+			name:             "not dispatchable",
+			file:             "on:\n  push:\n    branches: [main]\n",
+			wantDispatchable: false,
+		},
+		{
+			name:             "dispatch without inputs",
+			file:             "on:\n  push:\n  workflow_dispatch:\n",
+			wantDispatchable: true,
+		},
+		{
+			name: "inputs in declaration order",
+			file: `on:
+  workflow_dispatch:
+    inputs:
+      version:
+        description: Version to release
+        required: true
+      level:
+        type: choice
+        default: minor
+        options: [major, minor, patch]
+      dry-run:
+        type: boolean
+        default: true
+      target:
+        type: environment
+`,
+			wantDispatchable: true,
+			wantInputs: []*domain.WorkflowInput{
+				{Name: "version", Description: "Version to release", Type: "string", Required: true},
+				{Name: "level", Type: "choice", Default: "minor", Options: []string{"major", "minor", "patch"}},
+				{Name: "dry-run", Type: "boolean", Default: "true"},
+				{Name: "target", Type: "environment"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inputs, dispatchable, err := ParseWorkflowDispatch([]byte(tt.file))
+			if err != nil {
+				t.Fatalf("ParseWorkflowDispatch() error = %v", err)
+			}
+			if dispatchable != tt.wantDispatchable {
+				t.Errorf("dispatchable = %v, want %v", dispatchable, tt.wantDispatchable)
+			}
+			if !reflect.DeepEqual(inputs, tt.wantInputs) {
+				t.Errorf("inputs = %+v, want %+v", inputs, tt.wantInputs)
+			}
+		})
+	}
+}
+
+func TestParseWorkflowDispatchInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+	}{
+		{"not a mapping", "- a\n- b\n"},
+		{"unclosed flow mapping", "on: {a\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := ParseWorkflowDispatch([]byte(tt.file)); err == nil {
+				t.Error("ParseWorkflowDispatch() error = nil, want error")
+			}
+		})
+	}
+}
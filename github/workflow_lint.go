@@ -0,0 +1,268 @@
+package github
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+	"github.com/goccy/go-yaml/ast"
+	"github.com/goccy/go-yaml/parser"
+
+	"github.com/skanehira/ght/domain"
+)
+
+var (
+	// workflowEvents are the events that can trigger a workflow.
+	workflowEvents = map[string]bool{
+		"branch_protection_rule":      true,
+		"check_run":                   true,
+		"check_suite":                 true,
+		"create":                      true,
+		"delete":                      true,
+		"deployment":                  true,
+		"deployment_status":           true,
+		"discussion":                  true,
+		"discussion_comment":          true,
+		"fork":                        true,
+		"gollum":                      true,
+		"issue_comment":               true,
+		"issues":                      true,
+		"label":                       true,
+		"merge_group":                 true,
+		"milestone":                   true,
+		"page_build":                  true,
+		"public":                      true,
+		"pull_request":                true,
+		"pull_request_review":         true,
+		"pull_request_review_comment": true,
+		"pull_request_target":         true,
+		"push":                        true,
+		"registry_package":            true,
+		"release":                     true,
+		"repository_dispatch":         true,
+		"schedule":                    true,
+		"status":                      true,
+		"watch":                       true,
+		"workflow_call":               true,
+		"workflow_dispatch":           true,
+		"workflow_run":                true,
+	}
+
+	// firstPartyActionOwners are the owners of actions maintained by GitHub,
+	// which need not be pinned to a commit.
+	firstPartyActionOwners = map[string]bool{
+		"actions": true,
+		"github":  true,
+	}
+
+	commitSHARegex = regexp.MustCompile(`^[0-9a-f]{40}$`)
+)
+
+// ParseWorkflowDefinition parses a workflow file into its triggers and jobs,
+// and lints it for unknown events, jobs without runs-on, duplicate job ids
+// and third-party actions that are not pinned to a commit SHA.
+func ParseWorkflowDefinition(data []byte) (*domain.WorkflowDefinition, error) {
+	workflow, err := parseWorkflowFile(data)
+	if err != nil {
+		return nil, err
+	}
+	lines := workflowLines(data)
+	definition := &domain.WorkflowDefinition{}
+
+	warn := func(line int, format string, args ...interface{}) {
+		definition.Warnings = append(definition.Warnings, &domain.WorkflowWarning{
+			Line:    line,
+			Message: fmt.Sprintf(format, args...),
+		})
+	}
+
+	on, _ := lookup(workflow, "on")
+	for i, trigger := range workflowTriggers(on) {
+		event := fmt.Sprint(trigger.Key)
+		definition.Triggers = append(definition.Triggers, event)
+		if !workflowEvents[event] {
+			warn(lines.first("on."+event, fmt.Sprintf("on.%d", i), "on"), "unknown event %q", event)
+		}
+	}
+
+	jobsValue, _ := lookup(workflow, "jobs")
+	jobs, _ := jobsValue.(yaml.MapSlice)
+	seen := make(map[string]int)
+	for _, item := range jobs {
+		id := fmt.Sprint(item.Key)
+		path := "jobs." + id
+		occurrence := seen[id]
+		seen[id]++
+
+		spec, _ := item.Value.(yaml.MapSlice)
+		job := &domain.WorkflowDefinitionJob{
+			ID:   id,
+			Line: lines.at(path, occurrence),
+		}
+		if v, ok := lookup(spec, "name"); ok && v != nil {
+			job.Name = fmt.Sprint(v)
+		}
+		if v, ok := lookup(spec, "uses"); ok && v != nil {
+			job.Uses = fmt.Sprint(v)
+		}
+		if v, ok := lookup(spec, "needs"); ok {
+			job.Needs = stringList(v)
+		}
+		if v, ok := lookup(spec, "runs-on"); ok && v != nil {
+			job.RunsOn = formatRunsOn(v)
+		}
+		definition.Jobs = append(definition.Jobs, job)
+
+		if occurrence > 0 {
+			warn(job.Line, "duplicate job id %q", id)
+		}
+		if job.RunsOn == "" && job.Uses == "" {
+			warn(job.Line, "job %q has no runs-on", id)
+		}
+		if job.Uses != "" && !isPinnedAction(job.Uses) {
+			warn(lines.at(path+".uses", occurrence), "reusable workflow %q is not pinned to a commit SHA", job.Uses)
+		}
+
+		stepsValue, _ := lookup(spec, "steps")
+		steps, _ := stepsValue.([]interface{})
+		for i, s := range steps {
+			step, _ := s.(yaml.MapSlice)
+			uses, ok := lookup(step, "uses")
+			if !ok || uses == nil {
+				continue
+			}
+			if action := fmt.Sprint(uses); !isPinnedAction(action) {
+				warn(lines.at(fmt.Sprintf("%s.steps.%d.uses", path, i), occurrence), "action %q is not pinned to a commit SHA", action)
+			}
+		}
+	}
+
+	return definition, nil
+}
+
+// isPinnedAction reports whether the action or reusable workflow a step or job
+// uses is local, a docker image, maintained by GitHub or pinned to a commit.
+func isPinnedAction(uses string) bool {
+	if strings.HasPrefix(uses, "./") || strings.HasPrefix(uses, "docker://") {
+		return true
+	}
+	owner := strings.SplitN(uses, "/", 2)[0]
+	if firstPartyActionOwners[strings.ToLower(owner)] {
+		return true
+	}
+	i := strings.LastIndex(uses, "@")
+	return i >= 0 && commitSHARegex.MatchString(uses[i+1:])
+}
+
+// formatRunsOn formats the runs-on of a job, which can be a label, a list of
+// labels or a mapping with a runner group and labels.
+func formatRunsOn(v interface{}) string {
+	switch v := v.(type) {
+	case []interface{}:
+		return strings.Join(stringList(v), ", ")
+	case yaml.MapSlice:
+		var parts []string
+		for _, item := range v {
+			parts = append(parts, fmt.Sprintf("%v: %s", item.Key, strings.Join(stringList(item.Value), ", ")))
+		}
+		return strings.Join(parts, ", ")
+	}
+	return fmt.Sprint(v)
+}
+
+// stringList returns a list of strings for a scalar or a sequence of scalars.
+func stringList(v interface{}) []string {
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		list := make([]string, 0, len(v))
+		for _, item := range v {
+			list = append(list, fmt.Sprint(item))
+		}
+		return list
+	}
+	return []string{fmt.Sprint(v)}
+}
+
+// yamlLines maps the dot separated paths of the keys and sequence items of a
+// document, like "jobs.build.steps.0.uses", to the lines they are on. A path
+// has several lines when a key is duplicated.
+type yamlLines map[string][]int
+
+// at returns the line of the nth occurrence of path, falling back to the
+// first one, or 0 if path is unknown.
+func (l yamlLines) at(path string, n int) int {
+	lines := l[path]
+	if len(lines) == 0 {
+		return 0
+	}
+	if n < len(lines) {
+		return lines[n]
+	}
+	return lines[0]
+}
+
+// first returns the line of the first of paths that is known, or 0.
+func (l yamlLines) first(paths ...string) int {
+	for _, path := range paths {
+		if line := l.at(path, 0); line > 0 {
+			return line
+		}
+	}
+	return 0
+}
+
+// workflowLines finds the lines of the keys and sequence items of a workflow
+// file. Lines are only used to point at problems, so a document the parser
+// cannot handle just has none.
+func workflowLines(data []byte) (lines yamlLines) {
+	lines = make(yamlLines)
+	defer func() {
+		if r := recover(); r != nil {
+			lines = make(yamlLines)
+		}
+	}()
+
+	file, err := parser.ParseBytes(data, 0)
+	if err != nil || len(file.Docs) == 0 {
+		return lines
+	}
+	collectLines(lines, "", file.Docs[0].Body)
+	return lines
+}
+
+func collectLines(lines yamlLines, prefix string, node ast.Node) {
+	join := func(key string) string {
+		if prefix == "" {
+			return key
+		}
+		return prefix + "." + key
+	}
+
+	switch n := node.(type) {
+	case *ast.MappingNode:
+		for _, value := range n.Values {
+			collectLines(lines, prefix, value)
+		}
+	case *ast.MappingValueNode:
+		token := n.Key.GetToken()
+		path := join(token.Value)
+		lines[path] = append(lines[path], token.Position.Line)
+		collectLines(lines, path, n.Value)
+	case *ast.SequenceNode:
+		for i, value := range n.Values {
+			if value == nil {
+				continue
+			}
+			path := join(fmt.Sprint(i))
+			lines[path] = append(lines[path], value.GetToken().Position.Line)
+			collectLines(lines, path, value)
+		}
+	case *ast.AnchorNode:
+		collectLines(lines, prefix, n.Value)
+	case *ast.TagNode:
+		collectLines(lines, prefix, n.Value)
+	}
+}
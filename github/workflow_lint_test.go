@@ -0,0 +1,148 @@
+package github
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/skanehira/ght/domain"
+)
+
+func TestParseWorkflowDefinition(t *testing.T) {
+	file := `name: CI
+on:
+  push:
+    branches: [main]
+  pull_requests:
+jobs:
+  build:
+    name: Build
+    runs-on: [self-hosted, linux]
+    steps:
+      - uses: actions/checkout@v4
+      - uses: docker/setup-buildx-action@v3
+      - uses: docker/login-action@0d4c9c5ea7693da7b068278f7b52bda2a190a446
+      - uses: ./.github/actions/local
+      - run: make
+  test:
+    needs: build
+    steps:
+      - run: make test
+  release:
+    needs: [build, test]
+    uses: octo-org/workflows/.github/workflows/release.yml@main
+  build:
+    runs-on: ubuntu-latest
+`
+
+	got, err := ParseWorkflowDefinition([]byte(file))
+	if err != nil {
+		t.Fatalf("ParseWorkflowDefinition() error = %v", err)
+	}
+
+	if want := []string{"push", "pull_requests"}; !reflect.DeepEqual(got.Triggers, want) {
+		t.Errorf("Triggers = %v, want %v", got.Triggers, want)
+	}
+
+	wantJobs := []*domain.WorkflowDefinitionJob{
+		{ID: "build", Name: "Build", RunsOn: "self-hosted, linux", Line: 7},
+		{ID: "test", Needs: []string{"build"}, Line: 16},
+		{ID: "release", Needs: []string{"build", "test"}, Uses: "octo-org/workflows/.github/workflows/release.yml@main", Line: 20},
+		{ID: "build", RunsOn: "ubuntu-latest", Line: 23},
+	}
+	if !reflect.DeepEqual(got.Jobs, wantJobs) {
+		for _, j := range got.Jobs {
+			t.Logf("job %+v", j)
+		}
+		t.Errorf("Jobs differ from %v", wantJobs)
+	}
+
+	wantWarnings := []string{
+		`line 5: unknown event "pull_requests"`,
+		`line 12: action "docker/setup-buildx-action@v3" is not pinned to a commit SHA`,
+		`line 16: job "test" has no runs-on`,
+		`line 22: reusable workflow "octo-org/workflows/.github/workflows/release.yml@main" is not pinned to a commit SHA`,
+		`line 23: duplicate job id "build"`,
+	}
+	var warnings []string
+	for _, w := range got.Warnings {
+		warnings = append(warnings, w.String())
+	}
+	if !reflect.DeepEqual(warnings, wantWarnings) {
+		t.Errorf("Warnings = %q, want %q", warnings, wantWarnings)
+	}
+}
+
+func TestParseWorkflowDefinitionTriggers(t *testing.T) {
+	tests := []struct {
+		name         string
+		file         string
+		wantTriggers []string
+		wantWarnings []string
+	}{
+		{
+			name:         "single event",
+			file:         "on: push\n",
+			wantTriggers: []string{"push"},
+		},
+		{
+			name:         "unknown single event",
+			file:         "on: pushed\n",
+			wantTriggers: []string{"pushed"},
+			wantWarnings: []string{`line 1: unknown event "pushed"`},
+		},
+		{
+			name:         "list of events",
+			file:         "on: [push, schedule]\n",
+			wantTriggers: []string{"push", "schedule"},
+		},
+		{
+			name:         "unknown event in block list",
+			file:         "on:\n  - push\n  - pul_request\n",
+			wantTriggers: []string{"push", "pul_request"},
+			wantWarnings: []string{`line 3: unknown event "pul_request"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseWorkflowDefinition([]byte(tt.file))
+			if err != nil {
+				t.Fatalf("ParseWorkflowDefinition() error = %v", err)
+			}
+			if !reflect.DeepEqual(got.Triggers, tt.wantTriggers) {
+				t.Errorf("Triggers = %v, want %v", got.Triggers, tt.wantTriggers)
+			}
+			var warnings []string
+			for _, w := range got.Warnings {
+				warnings = append(warnings, w.String())
+			}
+			if !reflect.DeepEqual(warnings, tt.wantWarnings) {
+				t.Errorf("Warnings = %q, want %q", warnings, tt.wantWarnings)
+			}
+		})
+	}
+}
+
+func TestIsPinnedAction(t *testing.T) {
+	tests := []struct {
+		uses string
+		want bool
+	}{
+		{uses: "actions/checkout@v4", want: true},
+		{uses: "github/codeql-action/init@v3", want: true},
+		{uses: "./.github/actions/setup", want: true},
+		{uses: "docker://alpine:3.19", want: true},
+		{uses: "docker/login-action@v3", want: false},
+		{uses: "docker/login-action", want: false},
+		{uses: "docker/login-action@0d4c9c5ea7693da7b068278f7b52bda2a190a446", want: true},
+		{uses: "docker/login-action@0d4c9c5", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.uses, func(t *testing.T) {
+			if got := isPinnedAction(tt.uses); got != tt.want {
+				t.Errorf("isPinnedAction(%q) = %v, want %v", tt.uses, got, tt.want)
+			}
+		})
+	}
+}
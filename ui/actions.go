@@ -35,6 +35,14 @@ var (
 
 	logCancelFunc context.CancelFunc
 
+	// logPollInterval is how often the log of a running job is polled. The
+	// interval doubles up to logMaxPollInterval while the log does not change.
+	logPollInterval    = 3 * time.Second
+	logMaxPollInterval = 30 * time.Second
+
+	// logDownloadTimeout is how long downloading the full log of a job may take.
+	logDownloadTimeout = 5 * time.Minute
+
 	// statusFilterCycle defines the order for cycling through status filters.
 	statusFilterCycle = []string{"", "success", "failure", "in_progress", "queued"}
 )
@@ -58,11 +66,19 @@ func NewActionsUI() tview.Primitive {
 			owner := config.GitHub.Owner
 			repo := config.GitHub.Repo
 
-			opts := &gogithub.ListWorkflowRunsOptions{
-				ListOptions: gogithub.ListOptions{PerPage: 30},
+			opts, err := github.ParseRunFilter(RunFilterUI.GetQuery())
+			if err != nil {
+				UI.updater <- func() {
+					UI.Message(err.Error(), func() {
+						UI.app.SetFocus(RunFilterUI)
+					})
+				}
+				return nil, nil
 			}
+			opts.ListOptions = gogithub.ListOptions{PerPage: 30}
 
-			if actionsStatusFilter != "" {
+			// a status: filter takes precedence over the cycled status
+			if opts.Status == "" && actionsStatusFilter != "" {
 				opts.Status = actionsStatusFilter
 			}
 
@@ -76,7 +92,6 @@ func NewActionsUI() tview.Primitive {
 
 			var runs *gogithub.WorkflowRuns
 			var resp *gogithub.Response
-			var err error
 
 			if actionsWorkflowID > 0 {
 				runs, resp, err = github.ListWorkflowRunsByWorkflowID(ctx, owner, repo, actionsWorkflowID, opts)
@@ -119,6 +134,7 @@ func NewActionsUI() tview.Primitive {
 					run := item.(*domain.WorkflowRun)
 					currentRunID = run.ID
 					currentRunName = fmt.Sprintf("#%d - %s", run.RunNumber, run.Name)
+					currentAttempt = 0
 					actionsPages.SwitchToPage("jobs-view")
 					WorkflowJobsUI.focus()
 					UI.app.SetFocus(WorkflowJobsUI)
@@ -131,10 +147,44 @@ func NewActionsUI() tview.Primitive {
 			switch event.Rune() {
 			case 'r':
 				go WorkflowRunsUI.GetList()
+			case 'R':
+				rerunWorkflowRun(false)
+			case 'F':
+				rerunWorkflowRun(true)
+			case 'C':
+				cancelWorkflowRun()
 			case 's':
 				cycleStatusFilter()
 			case 'w':
 				showWorkflowSelector()
+			case 'a':
+				if item := WorkflowRunsUI.GetSelect(); item != nil {
+					run := item.(*domain.WorkflowRun)
+					currentRunID = run.ID
+					currentRunName = fmt.Sprintf("#%d - %s", run.RunNumber, run.Name)
+					openArtifacts()
+				}
+			case 'v':
+				if item := WorkflowRunsUI.GetSelect(); item != nil {
+					run := item.(*domain.WorkflowRun)
+					currentRunID = run.ID
+					currentRunName = fmt.Sprintf("#%d - %s", run.RunNumber, run.Name)
+					openPendingDeployments()
+				}
+			case 'h':
+				if item := WorkflowRunsUI.GetSelect(); item != nil {
+					openRunAttempts(item.(*domain.WorkflowRun))
+				}
+			case 'e':
+				WorkflowRunsUI.blur()
+				UI.app.SetFocus(RunFilterUI)
+				return nil
+			case 'b':
+				toggleBranchFilter()
+			case 'S':
+				openActionsStats()
+			case 'V':
+				openActionsSettings()
 			}
 
 			return event
@@ -158,14 +208,25 @@ func NewActionsUI() tview.Primitive {
 			owner := config.GitHub.Owner
 			repo := config.GitHub.Repo
 
-			jobs, err := github.ListWorkflowJobs(ctx, owner, repo, currentRunID, nil)
-			if err != nil {
-				log.Println(err)
-				return nil, nil
+			var jobs []*gogithub.WorkflowJob
+			if currentAttempt > 0 {
+				list, err := github.ListWorkflowJobsAttempt(ctx, owner, repo, currentRunID, currentAttempt)
+				if err != nil {
+					log.Println(err)
+					return nil, nil
+				}
+				jobs = list
+			} else {
+				list, err := github.ListWorkflowJobs(ctx, owner, repo, currentRunID, nil)
+				if err != nil {
+					log.Println(err)
+					return nil, nil
+				}
+				jobs = list.Jobs
 			}
 
-			items := make([]domain.Item, len(jobs.Jobs))
-			for i, job := range jobs.Jobs {
+			items := make([]domain.Item, len(jobs))
+			for i, job := range jobs {
 				items[i] = github.ConvertWorkflowJob(job)
 			}
 
@@ -177,7 +238,11 @@ func NewActionsUI() tview.Primitive {
 		ui.capture = func(event *tcell.EventKey) *tcell.EventKey {
 			switch event.Key() {
 			case tcell.KeyEscape:
-				switchToRunsView()
+				if currentAttempt > 0 {
+					closeAttemptJobs()
+				} else {
+					switchToRunsView()
+				}
 				return nil
 			case tcell.KeyCtrlO:
 				item := WorkflowJobsUI.GetSelect()
@@ -199,6 +264,12 @@ func NewActionsUI() tview.Primitive {
 			switch event.Rune() {
 			case 'r':
 				go WorkflowJobsUI.GetList()
+			case 'R':
+				rerunWorkflowJob()
+			case 's':
+				if item := WorkflowJobsUI.GetSelect(); item != nil {
+					openWorkflowSteps(item.(*domain.WorkflowJob))
+				}
 			}
 
 			return event
@@ -210,15 +281,24 @@ func NewActionsUI() tview.Primitive {
 	// --- Layout ---
 	actionsStatusLine = tview.NewTextView().
 		SetDynamicColors(true).
-		SetText("Actions | Status: all | Workflow: all | [s]tatus [w]orkflow [r]efresh")
+		SetText("Actions | Status: all | Workflow: all | [s]tatus [w]orkflow [e]dit filter [b]ranch [r]efresh [f]etch more [R]e-run [F]ailed re-run [C]ancel [a]rtifacts re[v]iew deployments [h]istory [S]tatistics [V]ariables & secrets")
 
 	actionsPages = tview.NewPages().
 		AddAndSwitchToPage("runs-view", WorkflowRunsUI, true).
-		AddPage("jobs-view", WorkflowJobsUI, true, false)
-
-	grid := tview.NewGrid().SetRows(1, 0).
+		AddPage("jobs-view", WorkflowJobsUI, true, false).
+		AddPage("steps-view", newWorkflowStepsUI(), true, false).
+		AddPage("artifacts-view", newArtifactsUI(), true, false).
+		AddPage("deployments-view", newPendingDeploymentsUI(), true, false).
+		AddPage("attempts-view", newRunAttemptsUI(), true, false).
+		AddPage("compare-view", newAttemptComparisonUI(), true, false).
+		AddPage("stats-view", newActionsStatsUI(), true, false).
+		AddPage("settings-view", newActionsSettingsUI(), true, false).
+		AddPage("workflow-view", newWorkflowFileUI(), true, false)
+
+	grid := tview.NewGrid().SetRows(1, 1, 0).
 		AddItem(actionsStatusLine, 0, 0, 1, 1, 0, 0, false).
-		AddItem(actionsPages, 1, 0, 1, 1, 0, 0, true)
+		AddItem(RunFilterUI, 1, 0, 1, 1, 0, 0, false).
+		AddItem(actionsPages, 2, 0, 1, 1, 0, 0, true)
 
 	return grid
 }
@@ -236,59 +316,332 @@ func switchToRunsView() {
 	updateActionsStatusLine()
 }
 
+// openWorkflowJobLog switches to the jobs of a workflow run in the Actions tab
+// and shows the log of one of its jobs.
+func openWorkflowJobLog(runID int64, runName string, job *domain.WorkflowJob) {
+	currentRunID = runID
+	currentRunName = runName
+	currentAttempt = 0
+
+	UI.pages.SwitchToPage("actions")
+	UI.activePage = "actions"
+	actionsPages.SwitchToPage("jobs-view")
+	WorkflowRunsUI.blur()
+	WorkflowJobsUI.focus()
+	UI.app.SetFocus(WorkflowJobsUI)
+	go WorkflowJobsUI.GetList()
+
+	fetchAndDisplayJobLog(job)
+}
+
 // fetchAndDisplayJobLog fetches a job's log and displays it in full-screen preview.
+// Logs of jobs that are not completed yet are followed until the job completes.
 func fetchAndDisplayJobLog(job *domain.WorkflowJob) {
 	// Cancel any previous log download
 	if logCancelFunc != nil {
 		logCancelFunc()
 	}
+	closeLogStore()
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	if job.Status != "completed" {
+		followJobLog(job)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), logDownloadTimeout)
 	logCancelFunc = cancel
 
 	actionsStatusLine.SetText(fmt.Sprintf("Loading log for: %s...", job.Name))
 
+	go openJobLogStore(ctx, job)
+}
+
+// openJobLogStore downloads the full log of a completed job to the cache and
+// shows it page by page.
+func openJobLogStore(ctx context.Context, job *domain.WorkflowJob) {
+	owner := config.GitHub.Owner
+	repo := config.GitHub.Repo
+
+	store, err := github.OpenJobLog(ctx, owner, repo, job.ID)
+	if err != nil {
+		ctxErr := ctx.Err()
+		// If context was cancelled by user navigation (not timeout), silently return
+		if ctxErr == context.Canceled {
+			return
+		}
+		UI.updater <- func() {
+			var msg string
+			if isNotFoundError(err) {
+				msg = "Log not available. The job may still be running or logs may have expired."
+			} else if ctxErr == context.DeadlineExceeded {
+				msg = "Log download timed out. Press Ctrl+O to view in browser."
+			} else {
+				msg = err.Error()
+			}
+			UI.Message(msg, focusJobLogParent)
+		}
+		return
+	}
+
+	annotations, err := store.Annotations()
+	if err != nil {
+		log.Println(err)
+	}
+
+	UI.app.QueueUpdateDraw(func() {
+		// another log may have been opened in the meantime
+		if ctx.Err() == context.Canceled {
+			store.Close()
+			return
+		}
+		actionsStatusLine.SetText(fmt.Sprintf("Log: %s | 'o' close | '/' search | ]/[: error | a: annotations | </>: page", job.Name))
+		showJobLogStore(job, store, annotations)
+	})
+}
+
+// rerunWorkflowRun asks for confirmation and re-runs all jobs, or only the
+// failed jobs, of the selected workflow run.
+func rerunWorkflowRun(failedOnly bool) {
+	item := WorkflowRunsUI.GetSelect()
+	if item == nil {
+		return
+	}
+	run := item.(*domain.WorkflowRun)
+
+	msg := fmt.Sprintf("Do you want to re-run all jobs of #%d - %s?", run.RunNumber, run.Name)
+	rerun := github.RerunWorkflowRun
+	if failedOnly {
+		msg = fmt.Sprintf("Do you want to re-run failed jobs of #%d - %s?", run.RunNumber, run.Name)
+		rerun = github.RerunFailedJobs
+	}
+
+	UI.Confirm(msg, "Re-run", func() error {
+		if err := rerun(context.Background(), config.GitHub.Owner, config.GitHub.Repo, run.ID); err != nil {
+			return err
+		}
+		refreshAfterAction(WorkflowRunsUI)
+		return nil
+	}, func() {
+		UI.app.SetFocus(WorkflowRunsUI)
+	})
+}
+
+// cancelWorkflowRun asks for confirmation and cancels the selected workflow run.
+func cancelWorkflowRun() {
+	item := WorkflowRunsUI.GetSelect()
+	if item == nil {
+		return
+	}
+	run := item.(*domain.WorkflowRun)
+
+	focus := func() {
+		UI.app.SetFocus(WorkflowRunsUI)
+	}
+	if run.Status == "completed" {
+		UI.Message(fmt.Sprintf("#%d - %s is already completed", run.RunNumber, run.Name), focus)
+		return
+	}
+
+	msg := fmt.Sprintf("Do you want to cancel #%d - %s?", run.RunNumber, run.Name)
+	UI.Confirm(msg, "Cancel run", func() error {
+		if err := github.CancelWorkflowRun(context.Background(), config.GitHub.Owner, config.GitHub.Repo, run.ID); err != nil {
+			return err
+		}
+		refreshAfterAction(WorkflowRunsUI)
+		return nil
+	}, focus)
+}
+
+// rerunWorkflowJob asks for confirmation and re-runs the selected job.
+func rerunWorkflowJob() {
+	item := WorkflowJobsUI.GetSelect()
+	if item == nil {
+		return
+	}
+	job := item.(*domain.WorkflowJob)
+
+	msg := fmt.Sprintf("Do you want to re-run %s?", job.Name)
+	UI.Confirm(msg, "Re-run", func() error {
+		if err := github.RerunWorkflowJob(context.Background(), config.GitHub.Owner, config.GitHub.Repo, job.ID); err != nil {
+			return err
+		}
+		refreshAfterAction(WorkflowJobsUI)
+		return nil
+	}, func() {
+		UI.app.SetFocus(WorkflowJobsUI)
+	})
+}
+
+// focusJobLogParent focuses the list a job log was opened from when the log is closed.
+func focusJobLogParent() {
+	if name, _ := actionsPages.GetFrontPage(); name == "compare-view" {
+		UI.app.SetFocus(AttemptComparisonUI)
+		return
+	}
+	UI.app.SetFocus(WorkflowJobsUI)
+}
+
+// refreshAfterAction reloads the list once GitHub has had time to apply the change.
+func refreshAfterAction(ui *SelectUI) {
+	go func() {
+		time.Sleep(1 * time.Second)
+		ui.GetList()
+	}()
+}
+
+// followJobLog shows the log of a running job in full-screen preview and polls
+// it, appending new output, until the job completes, the preview is closed or
+// logCancelFunc is called. The preview keeps scrolling to the newest output
+// unless the user scrolled up.
+func followJobLog(job *domain.WorkflowJob) {
+	ctx, cancel := context.WithCancel(context.Background())
+	logCancelFunc = cancel
+
+	actionsStatusLine.SetText(fmt.Sprintf("Following log: %s | 'o' close | '/' search", job.Name))
+	UI.FullScreenPreview(fmt.Sprintf("Waiting for log of %s...", job.Name), func() {
+		cancel()
+		focusJobLogParent()
+	})
+	CommonViewUI.ScrollToEnd()
+
 	go func() {
 		owner := config.GitHub.Owner
 		repo := config.GitHub.Repo
 
-		logContent, truncated, err := github.GetWorkflowJobLog(ctx, owner, repo, job.ID)
-		if err != nil {
-			ctxErr := ctx.Err()
-			// If context was cancelled by user navigation (not timeout), silently return
-			if ctxErr == context.Canceled {
+		interval := logPollInterval
+
+		// offset is how much of the raw log was downloaded, and pending is
+		// its last line, which is shown once it is complete
+		var offset int64
+		var pending, lastSteps string
+		var shown strings.Builder
+		for {
+			var completed bool
+			var steps string
+			if j, err := github.GetWorkflowJob(ctx, owner, repo, job.ID); err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				log.Println(err)
+			} else {
+				completed = j.GetStatus() == "completed"
+				steps = formatJobSteps(j)
+			}
+
+			raw, truncated, err := github.GetWorkflowJobLogFrom(ctx, owner, repo, job.ID, offset)
+			if ctx.Err() != nil {
 				return
 			}
-			UI.updater <- func() {
-				var msg string
-				if isNotFoundError(err) {
-					msg = "Log not available. The job may still be running or logs may have expired."
-				} else if ctxErr == context.DeadlineExceeded {
-					msg = "Log download timed out. The log may be very large. Press Ctrl+O to view in browser."
-				} else {
-					msg = err.Error()
+
+			var delta string
+			if err == nil {
+				offset += int64(len(raw))
+				raw = pending + raw
+				pending = ""
+				if i := strings.LastIndexByte(raw, '\n'); !completed && i < len(raw)-1 {
+					raw, pending = raw[:i+1], raw[i+1:]
 				}
-				UI.Message(msg, func() {
-					UI.app.SetFocus(WorkflowJobsUI)
+				delta = github.CleanLog(raw)
+			}
+
+			// the log is usually not available until the job completes,
+			// so show the progress of its steps until then
+			var update func()
+			changed := delta != ""
+			switch {
+			case err != nil && shown.Len() == 0:
+				if !isNotFoundError(err) {
+					log.Println(err)
+				}
+				changed = steps != lastSteps
+				lastSteps = steps
+				text := fmt.Sprintf("Waiting for log of %s...\n\n%s", job.Name, steps)
+				update = func() {
+					CommonViewUI.SetText(text)
+				}
+			case err != nil:
+				log.Println(err)
+			case delta != "" && shown.Len() == 0:
+				update = func() {
+					CommonViewUI.SetText(tview.Escape(delta)).ScrollToEnd()
+				}
+			case delta != "":
+				update = func() {
+					if _, err := CommonViewUI.Write([]byte(tview.Escape(delta))); err != nil {
+						log.Println(err)
+					}
+				}
+			}
+			shown.WriteString(delta)
+
+			// the polled log is capped, so show a complete log that is too
+			// large from the disk instead
+			if completed && err == nil && truncated {
+				UI.app.QueueUpdateDraw(func() {
+					if ctx.Err() == nil {
+						actionsStatusLine.SetText(fmt.Sprintf("Loading log for: %s...", job.Name))
+					}
 				})
+				go WorkflowJobsUI.GetList()
+				go openJobLogStore(ctx, job)
+				return
 			}
-			return
-		}
 
-		// Append truncation message if log was capped at 10MB
-		if truncated {
-			logContent += "\n\n--- Log truncated at 10MB. Press Ctrl+O on the job to view full log in browser. ---"
-		}
+			// color the complete log once and enable jumping between its errors
+			if completed && err == nil {
+				text := shown.String()
+				update = func() {
+					showJobLogText(job, text)
+				}
+			}
 
-		UI.updater <- func() {
-			actionsStatusLine.SetText(fmt.Sprintf("Log: %s | 'o' close | '/' search", job.Name))
-			UI.FullScreenPreview(logContent, func() {
-				UI.app.SetFocus(WorkflowJobsUI)
+			UI.app.QueueUpdateDraw(func() {
+				// the preview may show something else once following stopped
+				if ctx.Err() != nil {
+					return
+				}
+				if update != nil {
+					update()
+				}
+				if completed {
+					actionsStatusLine.SetText(fmt.Sprintf("Log: %s | 'o' close | '/' search | ]/[: error | a: annotations", job.Name))
+				}
 			})
+
+			if completed {
+				go WorkflowJobsUI.GetList()
+				return
+			}
+
+			// poll less often while nothing happens
+			if changed {
+				interval = logPollInterval
+			} else if interval *= 2; interval > logMaxPollInterval {
+				interval = logMaxPollInterval
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(interval):
+			}
 		}
 	}()
 }
 
+// formatJobSteps lists the steps of a job with their status.
+func formatJobSteps(job *gogithub.WorkflowJob) string {
+	var b strings.Builder
+	for _, step := range job.Steps {
+		status := step.GetStatus()
+		if status == "completed" {
+			status = step.GetConclusion()
+		}
+		fmt.Fprintf(&b, "%-12s %s\n", status, step.GetName())
+	}
+	return b.String()
+}
+
 // cycleStatusFilter advances to the next status filter and refreshes the list.
 func cycleStatusFilter() {
 	// Find current position in cycle
@@ -307,6 +660,36 @@ func cycleStatusFilter() {
 	go WorkflowRunsUI.GetList()
 }
 
+// toggleBranchFilter adds a branch: filter for the current git branch to the
+// runs filter, or removes it if it is there already.
+func toggleBranchFilter() {
+	branch := config.GitHub.Branch
+	if branch == "" {
+		return
+	}
+
+	token := "branch:" + branch
+	var words []string
+	found := false
+	for _, word := range strings.Fields(RunFilterUI.GetQuery()) {
+		if word == token {
+			found = true
+			continue
+		}
+		// only one branch can be filtered by
+		if strings.HasPrefix(word, "branch:") {
+			continue
+		}
+		words = append(words, word)
+	}
+	if !found {
+		words = append(words, token)
+	}
+
+	RunFilterUI.SetQuery(strings.Join(words, " "))
+	go WorkflowRunsUI.GetList()
+}
+
 // showWorkflowSelector opens a modal list of workflows for the user to select.
 func showWorkflowSelector() {
 	go func() {
@@ -326,7 +709,7 @@ func showWorkflowSelector() {
 
 		UI.app.QueueUpdateDraw(func() {
 			list := tview.NewList().ShowSecondaryText(false)
-			list.SetBorder(true).SetTitle("Select Workflow").SetTitleAlign(tview.AlignLeft)
+			list.SetBorder(true).SetTitle("Select Workflow (Enter: filter, d: run, v: view file)").SetTitleAlign(tview.AlignLeft)
 
 			// Add "All workflows" option first
 			list.AddItem("All workflows", "", 0, nil)
@@ -358,6 +741,23 @@ func showWorkflowSelector() {
 					UI.app.SetFocus(WorkflowRunsUI)
 					return nil
 				}
+				// dispatch the workflow under the cursor
+				if event.Rune() == 'd' {
+					if index := list.GetCurrentItem(); index > 0 {
+						UI.pages.RemovePage("workflow-selector")
+						UI.app.SetFocus(WorkflowRunsUI)
+						dispatchWorkflow(actionsWorkflows[index-1])
+					}
+					return nil
+				}
+				// view the file of the workflow under the cursor
+				if event.Rune() == 'v' {
+					if index := list.GetCurrentItem(); index > 0 {
+						UI.pages.RemovePage("workflow-selector")
+						openWorkflowFile(actionsWorkflows[index-1])
+					}
+					return nil
+				}
 				return event
 			})
 
@@ -371,12 +771,59 @@ func showWorkflowSelector() {
 func updateActionsStatusLine() {
 	// Check if we're in jobs view
 	name, _ := actionsPages.GetFrontPage()
-	if name == "jobs-view" {
+	switch name {
+	case "jobs-view":
+		runName := currentRunName
+		if currentAttempt > 0 {
+			runName = fmt.Sprintf("%s (attempt %d)", runName, currentAttempt)
+		}
 		actionsStatusLine.SetText(fmt.Sprintf(
-			"Run: %s | Esc: back | Ctrl+O: browser | [r]efresh",
+			"Run: %s | Esc: back | Ctrl+O: browser | [r]efresh [R]e-run job [s]teps",
+			runName,
+		))
+		return
+	case "stats-view":
+		actionsStatusLine.SetText("Statistics | Esc: back | [r]efresh | '/' search")
+		return
+	case "workflow-view":
+		actionsStatusLine.SetText(fmt.Sprintf(
+			"Workflow: %s | Esc: back | ]/[: next/previous warning | '/' search | n/N: next/previous match",
+			workflowFileName,
+		))
+		return
+	case "settings-view":
+		actionsStatusLine.SetText("Secrets and variables | Esc: back | [n]ew [e]dit [d]elete [r]efresh | '/' search")
+		return
+	case "attempts-view":
+		actionsStatusLine.SetText(fmt.Sprintf(
+			"Run: %s | Enter: jobs | Esc: back | Ctrl+J: select | [c]ompare [r]efresh",
+			currentRunName,
+		))
+		return
+	case "compare-view":
+		actionsStatusLine.SetText(fmt.Sprintf(
+			"Run: %s | Attempt #%d vs #%d | Enter: log of #%d | b: log of #%d | Esc: back",
+			currentRunName, compareBefore, compareAfter, compareAfter, compareBefore,
+		))
+		return
+	case "deployments-view":
+		actionsStatusLine.SetText(fmt.Sprintf(
+			"Run: %s | Esc: back | Ctrl+J: select | [a]pprove [x] reject [r]efresh",
+			currentRunName,
+		))
+		return
+	case "artifacts-view":
+		actionsStatusLine.SetText(fmt.Sprintf(
+			"Run: %s | Enter: browse | Esc: back | [d]ownload [r]efresh",
 			currentRunName,
 		))
 		return
+	case "steps-view":
+		actionsStatusLine.SetText(fmt.Sprintf(
+			"Job: %s | Enter: log | Esc: back | J/K: group | Enter: fold | E/C: expand/collapse all",
+			currentJob.Name,
+		))
+		return
 	}
 
 	statusText := "all"
@@ -388,7 +835,7 @@ func updateActionsStatusLine() {
 		workflowText = actionsWorkflowName
 	}
 	actionsStatusLine.SetText(fmt.Sprintf(
-		"Actions | Status: %s | Workflow: %s | [s]tatus [w]orkflow [r]efresh",
+		"Actions | Status: %s | Workflow: %s | [s]tatus [w]orkflow [e]dit filter [b]ranch [r]efresh [f]etch more [R]e-run [F]ailed re-run [C]ancel [a]rtifacts re[v]iew deployments [h]istory [S]tatistics [V]ariables & secrets",
 		statusText, workflowText,
 	))
 }
@@ -0,0 +1,335 @@
+package ui
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/skanehira/ght/config"
+	"github.com/skanehira/ght/domain"
+	"github.com/skanehira/ght/github"
+)
+
+var (
+	ArtifactsUI     *SelectUI
+	ArtifactFilesUI *SelectUI
+
+	// currentArchive is the zip archive of the artifact whose files are
+	// listed in ArtifactFilesUI.
+	currentArchive *zip.Reader
+
+	// artifactDownloadDir is the directory the last artifact was downloaded to.
+	artifactDownloadDir = "."
+)
+
+// newArtifactsUI creates the artifacts view with the artifacts of a run, the
+// files of the selected artifact and a preview of the selected file.
+func newArtifactsUI() tview.Primitive {
+	opt := func(ui *SelectUI) {
+		ui.header = []string{
+			"",
+			"Name",
+			"Size",
+			"Expires",
+		}
+		ui.hasHeader = true
+
+		ui.getList = func(cursor *string) ([]domain.Item, *github.PageInfo) {
+			if currentRunID == 0 {
+				return nil, nil
+			}
+
+			artifacts, err := github.ListWorkflowRunArtifacts(context.Background(), config.GitHub.Owner, config.GitHub.Repo, currentRunID)
+			if err != nil {
+				log.Println(err)
+				return nil, nil
+			}
+
+			items := make([]domain.Item, len(artifacts))
+			for i, artifact := range artifacts {
+				items[i] = github.ConvertArtifact(artifact)
+			}
+
+			// Artifacts are fetched all at once
+			pageInfo := &github.PageInfo{HasNextPage: false}
+			return items, pageInfo
+		}
+
+		ui.capture = func(event *tcell.EventKey) *tcell.EventKey {
+			switch event.Key() {
+			case tcell.KeyEscape:
+				closeArtifacts()
+				return nil
+			case tcell.KeyEnter:
+				if item := ArtifactsUI.GetSelect(); item != nil {
+					browseArtifact(item.(*domain.Artifact))
+				}
+				return nil
+			}
+
+			switch event.Rune() {
+			case 'r':
+				go ArtifactsUI.GetList()
+			case 'd':
+				if item := ArtifactsUI.GetSelect(); item != nil {
+					downloadArtifactForm(item.(*domain.Artifact))
+				}
+			}
+			return event
+		}
+	}
+
+	ArtifactsUI = NewSelectListUI(UIKindArtifact, tcell.ColorGreen, opt)
+
+	fileOpt := func(ui *SelectUI) {
+		ui.header = []string{
+			"",
+			"File",
+			"Size",
+		}
+		ui.hasHeader = true
+
+		ui.getList = func(cursor *string) ([]domain.Item, *github.PageInfo) {
+			if currentArchive == nil {
+				return nil, nil
+			}
+
+			files := github.ListArtifactFiles(currentArchive)
+			items := make([]domain.Item, len(files))
+			for i, file := range files {
+				items[i] = file
+			}
+			return items, &github.PageInfo{HasNextPage: false}
+		}
+
+		ui.capture = func(event *tcell.EventKey) *tcell.EventKey {
+			switch event.Key() {
+			case tcell.KeyEscape:
+				ArtifactFilesUI.blur()
+				ArtifactsUI.focus()
+				UI.app.SetFocus(ArtifactsUI)
+				return nil
+			case tcell.KeyEnter:
+				ArtifactFilesUI.blur()
+				UI.app.SetFocus(ArtifactViewUI)
+				return nil
+			}
+			return event
+		}
+	}
+
+	ArtifactFilesUI = NewSelectListUI(UIKindArtifactFile, tcell.ColorYellow, fileOpt)
+
+	ArtifactFilesUI.SetSelectionChangedFunc(func(row, col int) {
+		updateArtifactView(ArtifactFilesUI, row)
+	})
+
+	ArtifactViewUI.capture = func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEscape:
+			ArtifactFilesUI.focus()
+			UI.app.SetFocus(ArtifactFilesUI)
+			return nil
+		}
+		return event
+	}
+
+	grid := tview.NewGrid().SetColumns(-1, -2).SetRows(0, 0).
+		AddItem(ArtifactsUI, 0, 0, 1, 1, 0, 0, true).
+		AddItem(ArtifactFilesUI, 1, 0, 1, 1, 0, 0, true).
+		AddItem(ArtifactViewUI, 0, 1, 2, 1, 0, 0, true)
+
+	return grid
+}
+
+// openArtifacts switches to the artifacts view of the current run.
+func openArtifacts() {
+	currentArchive = nil
+	ArtifactsUI.SetList(nil)
+	ArtifactFilesUI.SetList(nil)
+	ArtifactViewUI.Clear()
+	ArtifactViewUI.SetTitle(string(UIKindArtifactView))
+
+	actionsPages.SwitchToPage("artifacts-view")
+	WorkflowRunsUI.blur()
+	ArtifactsUI.focus()
+	UI.app.SetFocus(ArtifactsUI)
+	updateActionsStatusLine()
+	go ArtifactsUI.GetList()
+}
+
+// closeArtifacts returns from the artifacts view to the runs view.
+func closeArtifacts() {
+	currentArchive = nil
+	ArtifactsUI.blur()
+	switchToRunsView()
+}
+
+// browseArtifact downloads the zip archive of an artifact into memory and
+// lists its files.
+func browseArtifact(artifact *domain.Artifact) {
+	focus := func() {
+		UI.app.SetFocus(ArtifactsUI)
+	}
+	if artifact.Expired {
+		UI.Message(fmt.Sprintf("%s has expired", artifact.Name), focus)
+		return
+	}
+
+	ArtifactViewUI.SetTitle(artifact.Name)
+	ArtifactViewUI.SetText(fmt.Sprintf("Downloading %s (%s)...", artifact.Name, artifact.Size))
+
+	go func() {
+		archive, err := github.ReadArtifact(context.Background(), config.GitHub.Owner, config.GitHub.Repo, artifact.ID)
+		if err != nil {
+			UI.updater <- func() {
+				ArtifactViewUI.Clear()
+				UI.Message(err.Error(), focus)
+			}
+			return
+		}
+
+		currentArchive = archive
+		ArtifactFilesUI.GetList()
+		UI.updater <- func() {
+			ArtifactViewUI.Clear()
+			ArtifactsUI.blur()
+			ArtifactFilesUI.focus()
+			UI.app.SetFocus(ArtifactFilesUI)
+		}
+	}()
+}
+
+func updateArtifactView(ui *SelectUI, row int) {
+	if currentArchive == nil || row < 1 || row > len(ui.items) {
+		return
+	}
+	file := ui.items[row-1].(*domain.ArtifactFile)
+	archive := currentArchive
+
+	// a file is decompressed to be shown, so it is read off the UI thread
+	go func() {
+		text, err := github.ReadArtifactFile(archive, file.Name)
+		if err != nil {
+			text = err.Error()
+		}
+
+		UI.updater <- func() {
+			// the cursor may have moved to another file in the meantime
+			if currentArchive != archive {
+				return
+			}
+			if item := ArtifactFilesUI.GetSelect(); item == nil || item.Key() != file.Key() {
+				return
+			}
+			ArtifactViewUI.SetTitle(fmt.Sprintf("%s (%s)", file.Name, file.Size))
+			ArtifactViewUI.SetText(tview.Escape(text)).ScrollToBeginning()
+		}
+	}()
+}
+
+// downloadArtifactForm shows a form to download the zip archive of an artifact to a directory.
+func downloadArtifactForm(artifact *domain.Artifact) {
+	focus := func() {
+		UI.app.SetFocus(ArtifactsUI)
+	}
+	if artifact.Expired {
+		UI.Message(fmt.Sprintf("%s has expired", artifact.Name), focus)
+		return
+	}
+
+	form := tview.NewForm()
+	form.SetBorder(true)
+	form.SetTitle(fmt.Sprintf("Download %s (%s)", artifact.Name, artifact.Size))
+	form.SetTitleAlign(tview.AlignLeft)
+
+	dirInput := tview.NewInputField().SetLabel("Directory").SetText(artifactDownloadDir)
+	form.AddFormItem(dirInput)
+
+	closeForm := func() {
+		UI.pages.RemovePage("download").ShowPage("actions")
+		focus()
+	}
+
+	form.AddButton("Download", func() {
+		dir := expandHome(dirInput.GetText())
+		closeForm()
+
+		artifactDownloadDir = dirInput.GetText()
+		path := filepath.Join(dir, artifact.Name+".zip")
+		actionsStatusLine.SetText(fmt.Sprintf("Downloading %s to %s...", artifact.Name, path))
+
+		go func() {
+			err := downloadArtifact(artifact, path)
+			UI.updater <- func() {
+				updateActionsStatusLine()
+				if err != nil {
+					UI.Message(err.Error(), focus)
+					return
+				}
+				UI.Message(fmt.Sprintf("Downloaded %s to %s", artifact.Name, path), focus)
+			}
+		}()
+	})
+	form.AddButton("Cancel", closeForm)
+
+	form.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyCtrlN:
+			k := tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone)
+			UI.app.QueueEvent(k)
+		case tcell.KeyCtrlP:
+			k := tcell.NewEventKey(tcell.KeyBacktab, 0, tcell.ModNone)
+			UI.app.QueueEvent(k)
+		case tcell.KeyEscape:
+			closeForm()
+			return nil
+		}
+		return event
+	})
+
+	UI.pages.AddAndSwitchToPage("download", UI.Modal(form, 80, 7), true).ShowPage("actions")
+}
+
+// downloadArtifact writes the zip archive of an artifact to path, creating
+// its directory if needed.
+func downloadArtifact(artifact *domain.Artifact, path string) (err error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+		// do not leave a broken archive behind
+		if err != nil {
+			os.Remove(path)
+		}
+	}()
+
+	return github.DownloadArtifact(context.Background(), config.GitHub.Owner, config.GitHub.Repo, artifact.ID, f)
+}
+
+// expandHome replaces a leading ~ in path with the home directory.
+func expandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		log.Println(err)
+		return path
+	}
+	return filepath.Join(home, path[1:])
+}
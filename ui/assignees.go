@@ -30,6 +30,12 @@ func NewAssignableUI() {
 
 	setOpt := func(ui *SelectUI) {
 		ui.capture = func(event *tcell.EventKey) *tcell.EventKey {
+			switch event.Rune() {
+			case 'a':
+				addIssueAssignees()
+			case 'd':
+				removeIssueAssignees()
+			}
 			return event
 		}
 	}
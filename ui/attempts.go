@@ -0,0 +1,282 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/skanehira/ght/config"
+	"github.com/skanehira/ght/domain"
+	"github.com/skanehira/ght/github"
+	"github.com/skanehira/ght/utils"
+)
+
+var (
+	WorkflowRunAttemptsUI *SelectUI
+	AttemptComparisonUI   *SelectUI
+
+	// currentRun is the run whose attempts are listed and currentAttempt the
+	// attempt whose jobs are listed in the jobs view, 0 for the latest one.
+	currentRun     *domain.WorkflowRun
+	currentAttempt int
+
+	// compareBefore and compareAfter are the attempts compared in the
+	// comparison view.
+	compareBefore int
+	compareAfter  int
+)
+
+// newRunAttemptsUI creates the attempts view with the attempts of a run.
+func newRunAttemptsUI() tview.Primitive {
+	opt := func(ui *SelectUI) {
+		ui.header = []string{
+			"",
+			"Attempt",
+			"Status",
+			"Triggered by",
+			"Started",
+			"Duration",
+		}
+		ui.hasHeader = true
+
+		ui.getList = func(cursor *string) ([]domain.Item, *github.PageInfo) {
+			run := currentRun
+			if run == nil {
+				return nil, nil
+			}
+
+			latest := run.Attempt
+			if latest < 1 {
+				latest = 1
+			}
+
+			attempts, err := github.ListWorkflowRunAttempts(context.Background(), config.GitHub.Owner, config.GitHub.Repo, run.ID, latest)
+			if err != nil {
+				log.Println(err)
+				return nil, nil
+			}
+
+			items := make([]domain.Item, len(attempts))
+			for i, attempt := range attempts {
+				items[i] = github.ConvertWorkflowRunAttempt(attempt)
+			}
+
+			// Attempts are fetched all at once
+			pageInfo := &github.PageInfo{HasNextPage: false}
+			return items, pageInfo
+		}
+
+		ui.capture = func(event *tcell.EventKey) *tcell.EventKey {
+			switch event.Key() {
+			case tcell.KeyEscape:
+				WorkflowRunAttemptsUI.blur()
+				switchToRunsView()
+				return nil
+			case tcell.KeyCtrlO:
+				if item := WorkflowRunAttemptsUI.GetSelect(); item != nil {
+					attempt := item.(*domain.WorkflowRunAttempt)
+					if err := utils.Open(attempt.HTMLURL); err != nil {
+						log.Println(err)
+					}
+				}
+			case tcell.KeyEnter:
+				if item := WorkflowRunAttemptsUI.GetSelect(); item != nil {
+					openAttemptJobs(item.(*domain.WorkflowRunAttempt).Attempt)
+				}
+				return nil
+			}
+
+			switch event.Rune() {
+			case 'r':
+				go WorkflowRunAttemptsUI.GetList()
+			case 'c':
+				compareSelectedAttempts()
+			}
+			return event
+		}
+	}
+
+	WorkflowRunAttemptsUI = NewSelectListUI(UIKindRunAttempt, tcell.ColorDarkCyan, opt)
+	return WorkflowRunAttemptsUI
+}
+
+// newAttemptComparisonUI creates the comparison view with the job results of two attempts side by side.
+func newAttemptComparisonUI() tview.Primitive {
+	opt := func(ui *SelectUI) {
+		ui.hasHeader = true
+
+		ui.getList = func(cursor *string) ([]domain.Item, *github.PageInfo) {
+			if currentRun == nil || compareBefore == 0 {
+				return nil, nil
+			}
+
+			ctx := context.Background()
+			owner := config.GitHub.Owner
+			repo := config.GitHub.Repo
+
+			var wg sync.WaitGroup
+			jobs := make([][]*domain.WorkflowJob, 2)
+			errs := make([]error, 2)
+			for i, attempt := range []int{compareBefore, compareAfter} {
+				wg.Add(1)
+				go func(i, attempt int) {
+					defer wg.Done()
+					list, err := github.ListWorkflowJobsAttempt(ctx, owner, repo, currentRun.ID, attempt)
+					errs[i] = err
+					for _, job := range list {
+						jobs[i] = append(jobs[i], github.ConvertWorkflowJob(job))
+					}
+				}(i, attempt)
+			}
+			wg.Wait()
+
+			for _, err := range errs {
+				if err != nil {
+					log.Println(err)
+					return nil, nil
+				}
+			}
+
+			comparisons := github.CompareJobs(jobs[0], jobs[1])
+			items := make([]domain.Item, len(comparisons))
+			for i, c := range comparisons {
+				items[i] = c
+			}
+			return items, &github.PageInfo{HasNextPage: false}
+		}
+
+		ui.capture = func(event *tcell.EventKey) *tcell.EventKey {
+			comparison := func() *domain.JobComparison {
+				if item := AttemptComparisonUI.GetSelect(); item != nil {
+					return item.(*domain.JobComparison)
+				}
+				return nil
+			}
+
+			switch event.Key() {
+			case tcell.KeyEscape:
+				AttemptComparisonUI.blur()
+				actionsPages.SwitchToPage("attempts-view")
+				WorkflowRunAttemptsUI.focus()
+				UI.app.SetFocus(WorkflowRunAttemptsUI)
+				updateActionsStatusLine()
+				return nil
+			case tcell.KeyEnter:
+				if c := comparison(); c != nil && c.After != nil {
+					fetchAndDisplayJobLog(c.After)
+				}
+				return nil
+			}
+
+			switch event.Rune() {
+			case 'b':
+				if c := comparison(); c != nil && c.Before != nil {
+					fetchAndDisplayJobLog(c.Before)
+				}
+			case 'r':
+				go AttemptComparisonUI.GetList()
+			}
+			return event
+		}
+	}
+
+	AttemptComparisonUI = NewSelectListUI(UIKindAttemptComparison, tcell.ColorOrange, opt)
+	return AttemptComparisonUI
+}
+
+// openRunAttempts switches to the attempts of a run.
+func openRunAttempts(run *domain.WorkflowRun) {
+	currentRun = run
+	currentRunID = run.ID
+	currentRunName = fmt.Sprintf("#%d - %s", run.RunNumber, run.Name)
+
+	WorkflowRunAttemptsUI.SetList(nil)
+	actionsPages.SwitchToPage("attempts-view")
+	WorkflowRunsUI.blur()
+	WorkflowRunAttemptsUI.focus()
+	UI.app.SetFocus(WorkflowRunAttemptsUI)
+	updateActionsStatusLine()
+	go WorkflowRunAttemptsUI.GetList()
+}
+
+// openAttemptJobs switches to the jobs of an attempt of the current run.
+func openAttemptJobs(attempt int) {
+	currentAttempt = attempt
+
+	WorkflowJobsUI.SetList(nil)
+	actionsPages.SwitchToPage("jobs-view")
+	WorkflowRunAttemptsUI.blur()
+	WorkflowJobsUI.focus()
+	UI.app.SetFocus(WorkflowJobsUI)
+	updateActionsStatusLine()
+	go WorkflowJobsUI.GetList()
+}
+
+// closeAttemptJobs returns from the jobs of an attempt to the attempts view.
+func closeAttemptJobs() {
+	if logCancelFunc != nil {
+		logCancelFunc()
+		logCancelFunc = nil
+	}
+	currentAttempt = 0
+
+	actionsPages.SwitchToPage("attempts-view")
+	WorkflowJobsUI.blur()
+	WorkflowRunAttemptsUI.focus()
+	UI.app.SetFocus(WorkflowRunAttemptsUI)
+	updateActionsStatusLine()
+}
+
+// compareSelectedAttempts compares the two selected attempts or, if none are
+// selected, the attempt under the cursor with the one before it.
+func compareSelectedAttempts() {
+	focus := func() {
+		UI.app.SetFocus(WorkflowRunAttemptsUI)
+	}
+
+	var attempts []int
+	for _, item := range WorkflowRunAttemptsUI.selected {
+		attempts = append(attempts, item.(*domain.WorkflowRunAttempt).Attempt)
+	}
+	switch len(attempts) {
+	case 0:
+		item := WorkflowRunAttemptsUI.GetSelect()
+		if item == nil {
+			return
+		}
+		attempt := item.(*domain.WorkflowRunAttempt).Attempt
+		if attempt == 1 {
+			UI.Message("the first attempt has no attempt to compare with", focus)
+			return
+		}
+		attempts = []int{attempt - 1, attempt}
+	case 2:
+		if attempts[0] > attempts[1] {
+			attempts[0], attempts[1] = attempts[1], attempts[0]
+		}
+	default:
+		UI.Message("select two attempts to compare", focus)
+		return
+	}
+
+	compareBefore, compareAfter = attempts[0], attempts[1]
+	WorkflowRunAttemptsUI.ClearSelected()
+	WorkflowRunAttemptsUI.UpdateView()
+
+	AttemptComparisonUI.header = []string{
+		"",
+		"Job",
+		fmt.Sprintf("Attempt #%d", compareBefore),
+		fmt.Sprintf("Attempt #%d", compareAfter),
+	}
+	AttemptComparisonUI.SetList(nil)
+	actionsPages.SwitchToPage("compare-view")
+	WorkflowRunAttemptsUI.blur()
+	AttemptComparisonUI.focus()
+	UI.app.SetFocus(AttemptComparisonUI)
+	updateActionsStatusLine()
+	go AttemptComparisonUI.GetList()
+}
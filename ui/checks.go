@@ -0,0 +1,166 @@
+package ui
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/shurcooL/githubv4"
+	"github.com/skanehira/ght/config"
+	"github.com/skanehira/ght/domain"
+	"github.com/skanehira/ght/github"
+	"github.com/skanehira/ght/utils"
+)
+
+var (
+	StatusChecksUI *SelectUI
+
+	checksStatusLine *tview.TextView
+	checksFocus      *focusRing
+
+	checksPullRequest *domain.PullRequest
+)
+
+// NewStatusChecksUI creates the checks page with the check runs and commit statuses of a pull request.
+func NewStatusChecksUI() tview.Primitive {
+	opt := func(ui *SelectUI) {
+		ui.header = []string{
+			"",
+			"State",
+			"Name",
+			"Required",
+			"Duration",
+		}
+		ui.hasHeader = true
+
+		ui.getList = func(cursor *string) ([]domain.Item, *github.PageInfo) {
+			pr := checksPullRequest
+			if pr == nil {
+				return nil, nil
+			}
+
+			number, err := strconv.Atoi(pr.Number)
+			if err != nil {
+				log.Println(err)
+				return nil, nil
+			}
+
+			status, err := github.GetPullRequestMergeStatus(map[string]interface{}{
+				"owner":  githubv4.String(pr.RepoOwner),
+				"name":   githubv4.String(pr.Repo),
+				"number": githubv4.Int(number),
+			})
+			if err != nil {
+				log.Println(err)
+				return nil, nil
+			}
+
+			state, checks := status.StatusChecks()
+			UI.updater <- func() {
+				updateChecksStatusLine(state)
+			}
+
+			// failing checks first
+			sort.SliceStable(checks, func(i, j int) bool {
+				return checks[i].IsFailing() && !checks[j].IsFailing()
+			})
+
+			items := make([]domain.Item, len(checks))
+			for i, c := range checks {
+				items[i] = c
+			}
+
+			// Contexts are fetched all at once
+			pageInfo := &github.PageInfo{HasNextPage: false}
+			return items, pageInfo
+		}
+
+		ui.capture = func(event *tcell.EventKey) *tcell.EventKey {
+			switch event.Key() {
+			case tcell.KeyEscape:
+				UI.switchToPage("pulls")
+				return nil
+			case tcell.KeyEnter:
+				if item := StatusChecksUI.GetSelect(); item != nil {
+					openStatusCheck(item.(*domain.StatusCheck))
+				}
+				return nil
+			case tcell.KeyCtrlO:
+				if item := StatusChecksUI.GetSelect(); item != nil {
+					if err := utils.Open(item.(*domain.StatusCheck).URL); err != nil {
+						log.Println(err)
+					}
+				}
+			}
+			switch event.Rune() {
+			case 'r':
+				go StatusChecksUI.GetList()
+			}
+			return event
+		}
+	}
+
+	StatusChecksUI = NewSelectListUI(UIKindStatusCheck, tcell.ColorGreen, opt)
+
+	checksStatusLine = tview.NewTextView().SetDynamicColors(true)
+
+	checksFocus = &focusRing{
+		primitives: []Primitive{StatusChecksUI},
+	}
+
+	grid := tview.NewGrid().SetRows(1, 0).
+		AddItem(checksStatusLine, 0, 0, 1, 1, 0, 0, false).
+		AddItem(StatusChecksUI, 1, 0, 1, 1, 0, 0, true)
+
+	return grid
+}
+
+// openStatusChecks switches to the checks page and loads the checks on the head commit of the pull request.
+func openStatusChecks(pr *domain.PullRequest) {
+	checksPullRequest = pr
+
+	StatusChecksUI.SetList(nil)
+	updateChecksStatusLine("")
+
+	UI.switchToPage("checks")
+	go StatusChecksUI.GetList()
+}
+
+// openStatusCheck shows the log of an Actions job in the Actions tab, and
+// opens any other check in the browser.
+func openStatusCheck(check *domain.StatusCheck) {
+	pr := checksPullRequest
+	// the Actions tab shows the repository ght was started in
+	if check.IsActions() && pr.RepoOwner == config.GitHub.Owner && pr.Repo == config.GitHub.Repo {
+		job := &domain.WorkflowJob{
+			ID:      check.JobID,
+			Name:    check.Name,
+			Status:  "completed",
+			HTMLURL: check.URL,
+			RunID:   check.RunID,
+		}
+		if check.IsPending() {
+			job.Status = "in_progress"
+		}
+		openWorkflowJobLog(check.RunID, check.RunName, job)
+		return
+	}
+
+	if check.URL == "" {
+		return
+	}
+	if err := utils.Open(check.URL); err != nil {
+		log.Println(err)
+	}
+}
+
+func updateChecksStatusLine(state string) {
+	pr := checksPullRequest
+	checksStatusLine.SetText(fmt.Sprintf(
+		"PR #%s %s | Checks: %s | Enter: log | Ctrl+O: browser | Esc: back | [r]efresh",
+		pr.Number, pr.Title, orNone(state),
+	))
+}
@@ -16,10 +16,65 @@ import (
 
 var CommentUI *SelectUI
 
+// commentsPageSize is how many comments are loaded at once. It is the size
+// of the first page of comments fetched with an issue too, so that searching
+// issues does not load more comments of each of them.
+const commentsPageSize = 30
+
 func NewCommentUI() {
 	setOpt := func(ui *SelectUI) {
+		ui.getList = func(cursor *string) ([]domain.Item, *github.PageInfo) {
+			item := IssueUI.GetSelect()
+			if item == nil {
+				return nil, nil
+			}
+			issue := item.(*domain.Issue)
+
+			v, err := commentsVariables(issue)
+			if err != nil {
+				log.Println(err)
+				return nil, nil
+			}
+			v["first"] = githubv4.NewInt(commentsPageSize)
+			v["after"] = (*githubv4.String)(cursor)
+
+			resp, err := github.GetIssueComments(v)
+			if err != nil {
+				log.Println(err)
+				return nil, nil
+			}
+			// the comments belong to the pane of another issue by now
+			if !isSelectedIssue(issue) {
+				return nil, nil
+			}
+
+			comments, page := resp.ToDomain()
+			UI.updater <- func() {
+				if cursor == nil {
+					issue.Comments = comments
+					issue.CommentsPage = page
+				} else {
+					issue.Comments = append(issue.Comments, comments...)
+					issue.CommentsPage.TotalCount = page.TotalCount
+					issue.CommentsPage.EndCursor = page.EndCursor
+					issue.CommentsPage.HasNext = page.HasNext
+				}
+				CommentUI.SetTitle(commentsTitle(issue))
+			}
+
+			pageInfo := &github.PageInfo{
+				EndCursor:   githubv4.String(page.EndCursor),
+				HasNextPage: githubv4.Boolean(page.HasNext),
+			}
+			return comments, pageInfo
+		}
+
 		ui.capture = func(event *tcell.EventKey) *tcell.EventKey {
 			switch event.Rune() {
+			case 'F':
+				go fetchOlderComments()
+			case 'L':
+				go fetchLatestComments()
 			case 'd':
 				deleteComment()
 			case 'n':
@@ -118,10 +173,9 @@ func createComment(item domain.Item, body string) error {
 		return err
 	}
 
-	if err := updateCommentUI(); err != nil {
-		return err
-	}
-
+	// the new comment is the latest one, which the first page of comments
+	// of an issue with many comments does not include
+	go fetchLatestComments()
 	return nil
 }
 
@@ -198,7 +252,7 @@ func editCommentBody(body *string) (err error) {
 		return
 	}
 
-	if *body == "" {
+	if strings.TrimSpace(*body) == "" {
 		return domain.ErrCommentBodyIsEmpty
 	}
 	return
@@ -229,9 +283,10 @@ func updateCommentUI() error {
 		return err
 	}
 	m := map[string]interface{}{
-		"owner":  githubv4.String(oldIssue.RepoOwner),
-		"name":   githubv4.String(oldIssue.Repo),
-		"number": githubv4.Int(number),
+		"owner":         githubv4.String(oldIssue.RepoOwner),
+		"name":          githubv4.String(oldIssue.Repo),
+		"number":        githubv4.Int(number),
+		"commentsFirst": githubv4.Int(commentsPageSize),
 	}
 
 	issue, err := github.GetIssue(m)
@@ -241,13 +296,136 @@ func updateCommentUI() error {
 
 	newIssue := issue.ToDomain()
 	IssueUI.UpdateItem(newIssue)
+	setComments(newIssue)
+	return nil
+}
 
-	if len(newIssue.Comments) > 0 {
-		CommentUI.SetList(newIssue.Comments)
-		CommentViewUI.updateView(newIssue.Comments[0].(*domain.Comment).Body)
-	} else {
+// setComments shows the loaded comments of the issue.
+func setComments(issue *domain.Issue) {
+	CommentUI.SetTitle(commentsTitle(issue))
+
+	if len(issue.Comments) == 0 {
+		// forget the comments and the next page of the previous issue
 		CommentUI.ClearView()
+		CommentUI.originItems = nil
+		CommentUI.items = nil
+		CommentUI.hasNext = false
+		CommentUI.cursor = nil
 		CommentViewUI.Clear()
+		return
+	}
+
+	CommentUI.SetList(issue.Comments)
+	CommentUI.hasNext = issue.CommentsPage.HasNext
+	cursor := issue.CommentsPage.EndCursor
+	CommentUI.cursor = &cursor
+	CommentViewUI.updateView(issue.Comments[0].(*domain.Comment).Body)
+}
+
+// commentsTitle returns the title of the comments pane with how many of the
+// comments of the issue are loaded.
+func commentsTitle(issue *domain.Issue) string {
+	return fmt.Sprintf("%s (%d/%d)", UIKindComment, len(issue.Comments), issue.CommentsPage.TotalCount)
+}
+
+// commentsVariables returns the variables of GetIssueComments for the issue
+// without a page.
+func commentsVariables(issue *domain.Issue) (map[string]interface{}, error) {
+	number, err := strconv.Atoi(issue.Number)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"owner":  githubv4.String(issue.RepoOwner),
+		"name":   githubv4.String(issue.Repo),
+		"number": githubv4.Int(number),
+		"first":  (*githubv4.Int)(nil),
+		"after":  (*githubv4.String)(nil),
+		"last":   (*githubv4.Int)(nil),
+		"before": (*githubv4.String)(nil),
+	}, nil
+}
+
+// fetchOlderComments loads the page of comments before the loaded comments of
+// the selected issue.
+func fetchOlderComments() {
+	item := IssueUI.GetSelect()
+	if item == nil {
+		return
+	}
+	issue := item.(*domain.Issue)
+	if !issue.CommentsPage.HasPrevious {
+		return
+	}
+
+	v, err := commentsVariables(issue)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	v["last"] = githubv4.NewInt(commentsPageSize)
+	v["before"] = githubv4.NewString(githubv4.String(issue.CommentsPage.StartCursor))
+
+	resp, err := github.GetIssueComments(v)
+	if err != nil {
+		showCommentsError(err)
+		return
+	}
+
+	comments, page := resp.ToDomain()
+	UI.updater <- func() {
+		issue.Comments = append(comments, issue.Comments...)
+		issue.CommentsPage.TotalCount = page.TotalCount
+		issue.CommentsPage.StartCursor = page.StartCursor
+		issue.CommentsPage.HasPrevious = page.HasPrevious
+		if isSelectedIssue(issue) {
+			setComments(issue)
+		}
+	}
+}
+
+// fetchLatestComments replaces the loaded comments of the selected issue with
+// its latest comments, from which older comments can be loaded.
+func fetchLatestComments() {
+	item := IssueUI.GetSelect()
+	if item == nil {
+		return
+	}
+	issue := item.(*domain.Issue)
+
+	v, err := commentsVariables(issue)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	v["last"] = githubv4.NewInt(commentsPageSize)
+
+	resp, err := github.GetIssueComments(v)
+	if err != nil {
+		showCommentsError(err)
+		return
+	}
+
+	comments, page := resp.ToDomain()
+	UI.updater <- func() {
+		issue.Comments, issue.CommentsPage = comments, page
+		if isSelectedIssue(issue) {
+			setComments(issue)
+		}
+	}
+}
+
+// isSelectedIssue reports whether issue is the issue under the cursor, whose
+// comments the comments pane shows.
+func isSelectedIssue(issue *domain.Issue) bool {
+	item := IssueUI.GetSelect()
+	return item != nil && item.Key() == issue.Key()
+}
+
+func showCommentsError(err error) {
+	UI.updater <- func() {
+		UI.Message(err.Error(), func() {
+			UI.app.SetFocus(CommentUI)
+		})
 	}
-	return nil
 }
@@ -0,0 +1,331 @@
+package ui
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/shurcooL/githubv4"
+	"github.com/skanehira/ght/config"
+	"github.com/skanehira/ght/github"
+	"github.com/skanehira/ght/utils"
+)
+
+// pullRequestTemplates are the paths GitHub looks up for a pull request template.
+var pullRequestTemplates = []string{
+	".github/pull_request_template.md",
+	".github/PULL_REQUEST_TEMPLATE.md",
+	"pull_request_template.md",
+	"docs/pull_request_template.md",
+}
+
+// createPullRequestForm shows a form to open a pull request from the current
+// git branch into the repository's default branch.
+func createPullRequestForm() {
+	// repo
+	var repo string
+	input := PullRequestFilterUI.GetQuery()
+	for _, word := range strings.Split(input, " ") {
+		if strings.Contains(word, "repo:") {
+			repo = strings.TrimPrefix(word, "repo:")
+			break
+		}
+	}
+
+	s := strings.Split(repo, "/")
+	if len(s) != 2 {
+		return
+	}
+	owner := s[0]
+	name := s[1]
+
+	focus := func() {
+		UI.app.SetFocus(PullRequestUI)
+	}
+
+	resp, err := github.GetRepo(map[string]interface{}{
+		"owner": githubv4.String(owner),
+		"name":  githubv4.String(name),
+	})
+	if err != nil {
+		UI.Message(err.Error(), focus)
+		return
+	}
+	repoID := resp.ID
+
+	// the current branch only makes sense for the repository ght was started in
+	var head string
+	if owner == config.GitHub.Owner && name == config.GitHub.Repo {
+		head = config.GitHub.Branch
+	}
+
+	form := tview.NewForm()
+	form.SetBorder(true)
+	form.SetTitle("New pull request")
+	form.SetTitleAlign(tview.AlignLeft)
+	inputWidth := 70
+
+	repoInput := tview.NewInputField().SetLabel("Repository").
+		SetText(repo).SetLabelWidth(inputWidth).
+		SetAcceptanceFunc(func(textToCheck string, lastChar rune) bool {
+			return false
+		})
+	form.AddFormItem(repoInput)
+
+	// graphql query variables
+	v := map[string]interface{}{
+		"owner":  githubv4.String(owner),
+		"name":   githubv4.String(name),
+		"first":  githubv4.Int(100),
+		"cursor": (*githubv4.String)(nil),
+	}
+
+	// branches
+	baseInput := tview.NewInputField().SetLabel("Base").SetLabelWidth(inputWidth).
+		SetText(string(resp.DefaultBranchRef.Name))
+	form.AddFormItem(baseInput)
+	headInput := tview.NewInputField().SetLabel("Head").SetLabelWidth(inputWidth).
+		SetText(head)
+	form.AddFormItem(headInput)
+	go func() {
+		resp, err := github.GetRepoBranches(v)
+		if err != nil {
+			log.Println(err)
+			return
+		}
+
+		var branches []string
+		for _, ref := range resp.Nodes {
+			branches = append(branches, string(ref.Name))
+		}
+		complete := func(text string) []string {
+			return branchAutocompleteFunc(text, branches)
+		}
+		baseInput.SetAutocompleteFunc(complete)
+		headInput.SetAutocompleteFunc(complete)
+	}()
+
+	// title
+	titleInput := tview.NewInputField().SetLabel("Title").SetLabelWidth(inputWidth)
+	form.AddFormItem(titleInput)
+
+	draftCheckbox := tview.NewCheckbox().SetLabel("Draft").SetLabelWidth(inputWidth)
+	form.AddFormItem(draftCheckbox)
+
+	// reviewers and assignees
+	reviewersInput := tview.NewInputField().SetLabel("Reviewers").SetLabelWidth(inputWidth)
+	form.AddFormItem(reviewersInput)
+	assigneesInput := tview.NewInputField().SetLabel("Assignees").SetLabelWidth(inputWidth)
+	form.AddFormItem(assigneesInput)
+	userMap := map[string]githubv4.ID{}
+	go func() {
+		resp, err := github.GetRepoAssignableUsers(v)
+		if err != nil {
+			log.Println(err)
+			return
+		}
+
+		var users []string
+		for _, u := range resp.Nodes {
+			name := string(u.Login)
+			userMap[name] = u.ID
+			users = append(users, name)
+		}
+		reviewersInput.SetAutocompleteFunc(func(text string) []string {
+			return autocompleteFunc(text, users)
+		})
+		assigneesInput.SetAutocompleteFunc(func(text string) []string {
+			return autocompleteFunc(text, users)
+		})
+	}()
+
+	// labels
+	labelInput := tview.NewInputField().SetLabel("Labels").SetLabelWidth(inputWidth)
+	labelMap := map[string]githubv4.ID{}
+	form.AddFormItem(labelInput)
+	go func() {
+		resp, err := github.GetRepoLabels(v)
+		if err != nil {
+			log.Println(err)
+			return
+		}
+
+		var labels []string
+		for _, l := range resp.Nodes {
+			name := string(l.Name)
+			labelMap[name] = l.ID
+			labels = append(labels, name)
+		}
+		labelInput.SetAutocompleteFunc(func(text string) []string {
+			return autocompleteFunc(text, labels)
+		})
+	}()
+
+	// milestones
+	milestoneDropDown := tview.NewDropDown().SetLabel("MileStone").SetLabelWidth(inputWidth)
+	var milestoneID *githubv4.ID
+	form.AddFormItem(milestoneDropDown)
+	go func() {
+		resp, err := github.GetRepoMillestones(v)
+		if err != nil {
+			log.Println(err)
+			return
+		}
+
+		milestones := map[string]*githubv4.ID{}
+		var titles []string
+		for _, milestone := range resp.Nodes {
+			title := string(milestone.Title)
+			milestones[title] = &milestone.ID
+			titles = append(titles, title)
+		}
+		UI.app.QueueUpdateDraw(func() {
+			milestoneDropDown.SetOptions(titles, func(text string, index int) {
+				milestoneID = milestones[text]
+			})
+		})
+	}()
+
+	// template
+	var prBody string
+	go func() {
+		for _, path := range pullRequestTemplates {
+			text, err := github.GetRepoFileText(map[string]interface{}{
+				"owner":      githubv4.String(owner),
+				"name":       githubv4.String(name),
+				"expression": githubv4.String("HEAD:" + path),
+			})
+			if err != nil {
+				log.Println(err)
+				return
+			}
+			if text == "" {
+				continue
+			}
+
+			UI.app.QueueUpdate(func() {
+				// do not overwrite a body that was already edited
+				if prBody == "" {
+					prBody = text
+				}
+			})
+			return
+		}
+	}()
+
+	form.AddButton("Edit Body", func() {
+		UI.app.Suspend(func() {
+			if err := utils.Edit(&prBody); err != nil {
+				log.Println(err)
+				return
+			}
+		})
+	})
+	form.AddButton("Create", func() {
+		input := githubv4.CreatePullRequestInput{
+			RepositoryID: repoID,
+			BaseRefName:  githubv4.String(baseInput.GetText()),
+			HeadRefName:  githubv4.String(headInput.GetText()),
+			Title:        githubv4.String(titleInput.GetText()),
+			Body:         githubv4.NewString(githubv4.String(prBody)),
+			Draft:        githubv4.NewBoolean(githubv4.Boolean(draftCheckbox.IsChecked())),
+		}
+
+		prID, err := github.CreatePullRequest(input)
+		if err != nil {
+			UI.Message(err.Error(), func() {
+				UI.pages.SwitchToPage("form").ShowPage("pulls")
+			})
+			return
+		}
+
+		UI.pages.RemovePage("form").ShowPage("pulls")
+		focus()
+		go func() {
+			time.Sleep(1 * time.Second)
+			PullRequestUI.GetList()
+		}()
+
+		// labels, assignees, milestone and reviewers can only be set after creation
+		err = updateCreatedPullRequest(prID,
+			splitNames(labelInput.GetText(), labelMap),
+			splitNames(assigneesInput.GetText(), userMap),
+			milestoneID,
+			splitNames(reviewersInput.GetText(), userMap),
+		)
+		if err != nil {
+			UI.Message(fmt.Sprintf("pull request was created, but %s", err), focus)
+		}
+	})
+	form.AddButton("Cancel", func() {
+		UI.pages.RemovePage("form").ShowPage("pulls")
+		focus()
+	})
+
+	form.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyCtrlN:
+			k := tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone)
+			UI.app.QueueEvent(k)
+		case tcell.KeyCtrlP:
+			k := tcell.NewEventKey(tcell.KeyBacktab, 0, tcell.ModNone)
+			UI.app.QueueEvent(k)
+		}
+		return event
+	})
+
+	form.SetFocus(2)
+	if head != "" {
+		form.SetFocus(3)
+	}
+	UI.pages.AddAndSwitchToPage("form", UI.Modal(form, 100, 23), true).ShowPage("pulls")
+}
+
+// updateCreatedPullRequest sets the labels, assignees and milestone of a newly
+// created pull request and requests reviews from the reviewers.
+func updateCreatedPullRequest(id githubv4.ID, labelIDs, assigneeIDs []githubv4.ID, milestoneID *githubv4.ID, reviewerIDs []githubv4.ID) error {
+	if len(labelIDs) > 0 || len(assigneeIDs) > 0 || milestoneID != nil {
+		input := githubv4.UpdatePullRequestInput{
+			PullRequestID: id,
+			MilestoneID:   milestoneID,
+		}
+		if len(labelIDs) > 0 {
+			input.LabelIDs = &labelIDs
+		}
+		if len(assigneeIDs) > 0 {
+			input.AssigneeIDs = &assigneeIDs
+		}
+		if err := github.UpdatePullRequest(input); err != nil {
+			return fmt.Errorf("failed to update it: %w", err)
+		}
+	}
+
+	if len(reviewerIDs) > 0 {
+		input := githubv4.RequestReviewsInput{
+			PullRequestID: id,
+			UserIDs:       &reviewerIDs,
+		}
+		if err := github.RequestReviews(input); err != nil {
+			return fmt.Errorf("failed to request reviews: %w", err)
+		}
+	}
+	return nil
+}
+
+// branchAutocompleteFunc completes a single branch name.
+func branchAutocompleteFunc(text string, branches []string) []string {
+	if text == "" {
+		return nil
+	}
+
+	var results []string
+	for _, b := range branches {
+		if strings.Contains(strings.ToLower(b), strings.ToLower(text)) {
+			results = append(results, b)
+		}
+	}
+	return results
+}
@@ -0,0 +1,178 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/skanehira/ght/config"
+	"github.com/skanehira/ght/domain"
+	"github.com/skanehira/ght/github"
+)
+
+var PendingDeploymentsUI *SelectUI
+
+// newPendingDeploymentsUI creates the view with the deployments of a run that
+// wait for the review of a protected environment.
+func newPendingDeploymentsUI() tview.Primitive {
+	opt := func(ui *SelectUI) {
+		ui.header = []string{
+			"",
+			"Environment",
+			"Reviewers",
+			"Wait timer",
+			"Can approve",
+		}
+		ui.hasHeader = true
+
+		ui.getList = func(cursor *string) ([]domain.Item, *github.PageInfo) {
+			if currentRunID == 0 {
+				return nil, nil
+			}
+
+			deployments, err := github.GetPendingDeployments(context.Background(), config.GitHub.Owner, config.GitHub.Repo, currentRunID)
+			if err != nil {
+				log.Println(err)
+				return nil, nil
+			}
+
+			items := make([]domain.Item, len(deployments))
+			for i, deployment := range deployments {
+				items[i] = github.ConvertPendingDeployment(deployment)
+			}
+
+			// Pending deployments are not paginated
+			pageInfo := &github.PageInfo{HasNextPage: false}
+			return items, pageInfo
+		}
+
+		ui.capture = func(event *tcell.EventKey) *tcell.EventKey {
+			switch event.Key() {
+			case tcell.KeyEscape:
+				PendingDeploymentsUI.blur()
+				switchToRunsView()
+				return nil
+			}
+
+			switch event.Rune() {
+			case 'r':
+				go PendingDeploymentsUI.GetList()
+			case 'a':
+				reviewDeploymentsForm("approved")
+			case 'x':
+				reviewDeploymentsForm("rejected")
+			}
+			return event
+		}
+	}
+
+	PendingDeploymentsUI = NewSelectListUI(UIKindPendingDeployment, tcell.ColorOrange, opt)
+	return PendingDeploymentsUI
+}
+
+// openPendingDeployments switches to the pending deployments of the current run.
+func openPendingDeployments() {
+	PendingDeploymentsUI.SetList(nil)
+
+	actionsPages.SwitchToPage("deployments-view")
+	WorkflowRunsUI.blur()
+	PendingDeploymentsUI.focus()
+	UI.app.SetFocus(PendingDeploymentsUI)
+	updateActionsStatusLine()
+	go PendingDeploymentsUI.GetList()
+}
+
+func getSelectedPendingDeployments() []*domain.PendingDeployment {
+	var deployments []*domain.PendingDeployment
+	if len(PendingDeploymentsUI.selected) == 0 {
+		data := PendingDeploymentsUI.GetSelect()
+		if data != nil {
+			deployments = append(deployments, data.(*domain.PendingDeployment))
+		}
+	} else {
+		for _, item := range PendingDeploymentsUI.selected {
+			deployments = append(deployments, item.(*domain.PendingDeployment))
+		}
+	}
+	return deployments
+}
+
+// reviewDeploymentsForm shows a form to approve (state "approved") or reject
+// (state "rejected") the selected pending deployments with a comment.
+func reviewDeploymentsForm(state string) {
+	focus := func() {
+		UI.app.SetFocus(PendingDeploymentsUI)
+	}
+
+	var environmentIDs []int64
+	var names []string
+	for _, d := range getSelectedPendingDeployments() {
+		if !d.CanApprove {
+			UI.Message(fmt.Sprintf("you are not allowed to review deployments to %s", d.Environment), focus)
+			return
+		}
+		environmentIDs = append(environmentIDs, d.EnvironmentID)
+		names = append(names, d.Environment)
+	}
+	if len(environmentIDs) == 0 {
+		return
+	}
+
+	label := "Approve"
+	if state == "rejected" {
+		label = "Reject"
+	}
+
+	form := tview.NewForm()
+	form.SetBorder(true)
+	form.SetTitle(fmt.Sprintf("%s deployment to %s", label, strings.Join(names, ", ")))
+	form.SetTitleAlign(tview.AlignLeft)
+
+	commentInput := tview.NewInputField().SetLabel("Comment")
+	form.AddFormItem(commentInput)
+
+	closeForm := func() {
+		UI.pages.RemovePage("review-deployments").ShowPage("actions")
+		focus()
+	}
+
+	form.AddButton(label, func() {
+		runID := currentRunID
+		comment := commentInput.GetText()
+		closeForm()
+
+		go func() {
+			err := github.ReviewPendingDeployments(context.Background(), config.GitHub.Owner, config.GitHub.Repo, runID, environmentIDs, state, comment)
+			if err != nil {
+				UI.updater <- func() {
+					UI.Message(err.Error(), focus)
+				}
+				return
+			}
+			PendingDeploymentsUI.ClearSelected()
+			PendingDeploymentsUI.GetList()
+			refreshAfterAction(WorkflowRunsUI)
+		}()
+	})
+	form.AddButton("Cancel", closeForm)
+
+	form.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyCtrlN:
+			k := tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone)
+			UI.app.QueueEvent(k)
+		case tcell.KeyCtrlP:
+			k := tcell.NewEventKey(tcell.KeyBacktab, 0, tcell.ModNone)
+			UI.app.QueueEvent(k)
+		case tcell.KeyEscape:
+			closeForm()
+			return nil
+		}
+		return event
+	})
+
+	UI.pages.AddAndSwitchToPage("review-deployments", UI.Modal(form, 80, 7), true).ShowPage("actions")
+}
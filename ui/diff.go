@@ -0,0 +1,267 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/skanehira/ght/domain"
+	"github.com/skanehira/ght/github"
+	"github.com/skanehira/ght/utils"
+)
+
+var (
+	PullRequestFilesUI *SelectUI
+
+	diffStatusLine *tview.TextView
+	diffFocus      *focusRing
+
+	currentPullRequest *domain.PullRequest
+	currentFile        *domain.PullRequestFile
+
+	// diffLines are the parsed lines of the current file's patch and
+	// diffCursor is the index of the line review comments are added to.
+	diffLines  []domain.DiffLine
+	diffCursor int
+)
+
+// NewPullRequestDiffUI creates the diff page with the changed files of a pull request and their diff.
+func NewPullRequestDiffUI() tview.Primitive {
+	opt := func(ui *SelectUI) {
+		ui.header = []string{
+			"",
+			"Status",
+			"+",
+			"-",
+			"File",
+		}
+		ui.hasHeader = true
+
+		ui.getList = func(cursor *string) ([]domain.Item, *github.PageInfo) {
+			pr := currentPullRequest
+			if pr == nil {
+				return nil, nil
+			}
+
+			number, err := strconv.Atoi(pr.Number)
+			if err != nil {
+				log.Println(err)
+				return nil, nil
+			}
+
+			files, err := github.ListPullRequestFiles(context.Background(), pr.RepoOwner, pr.Repo, number)
+			if err != nil {
+				log.Println(err)
+				return nil, nil
+			}
+
+			items := make([]domain.Item, len(files))
+			for i, file := range files {
+				items[i] = github.ConvertPullRequestFile(file)
+			}
+
+			// Files are fetched all at once
+			pageInfo := &github.PageInfo{HasNextPage: false}
+			return items, pageInfo
+		}
+
+		ui.capture = func(event *tcell.EventKey) *tcell.EventKey {
+			switch event.Key() {
+			case tcell.KeyEscape:
+				UI.switchToPage("pulls")
+				return nil
+			case tcell.KeyEnter:
+				diffFocus.focusAt(1)
+				return nil
+			case tcell.KeyCtrlO:
+				item := PullRequestFilesUI.GetSelect()
+				if item != nil {
+					if err := utils.Open(item.(*domain.PullRequestFile).BlobURL); err != nil {
+						log.Println(err)
+					}
+				}
+			}
+			return event
+		}
+	}
+
+	ui := NewSelectListUI(UIKindPullRequestFile, tcell.ColorLightSalmon, opt)
+
+	ui.SetSelectionChangedFunc(func(row, col int) {
+		updateDiffView(ui, row)
+	})
+
+	PullRequestFilesUI = ui
+
+	// the patch is escaped, so the generic search cannot add its tags to it
+	DiffViewUI.search = searchDiff
+	DiffViewUI.showLine = showDiffLine
+	DiffViewUI.capture = func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEscape:
+			diffFocus.focusAt(0)
+			return nil
+		}
+		switch event.Rune() {
+		case ']':
+			moveDiffFile(1)
+		case '[':
+			moveDiffFile(-1)
+		case 'J':
+			moveDiffCursor(1)
+		case 'K':
+			moveDiffCursor(-1)
+		case 'c':
+			if err := addReviewComment(); err != nil {
+				UI.Message(err.Error(), func() {
+					UI.app.SetFocus(DiffViewUI)
+				})
+			}
+		case 'P':
+			showPendingReview()
+		case 'S':
+			submitReviewForm()
+		case 'X':
+			discardPendingReview()
+		}
+		return event
+	}
+
+	diffStatusLine = tview.NewTextView().SetDynamicColors(true)
+
+	diffFocus = &focusRing{
+		primitives: []Primitive{PullRequestFilesUI, DiffViewUI},
+	}
+
+	grid := tview.NewGrid().SetRows(1, 0).SetColumns(-1, -2).
+		AddItem(diffStatusLine, 0, 0, 1, 2, 0, 0, false).
+		AddItem(PullRequestFilesUI, 1, 0, 1, 1, 0, 0, true).
+		AddItem(DiffViewUI, 1, 1, 1, 1, 0, 0, true)
+
+	return grid
+}
+
+// openPullRequestDiff switches to the diff page and loads the changed files of the pull request.
+func openPullRequestDiff(pr *domain.PullRequest) {
+	currentPullRequest = pr
+	currentFile = nil
+	diffLines = nil
+
+	PullRequestFilesUI.SetList(nil)
+	DiffViewUI.Clear()
+	DiffViewUI.SetTitle(string(UIKindDiffView))
+	updateDiffStatusLine()
+
+	diffFocus.current = 0
+	UI.switchToPage("diff")
+	go PullRequestFilesUI.GetList()
+}
+
+// moveDiffFile selects the next (delta 1) or previous (delta -1) file and shows its diff.
+func moveDiffFile(delta int) {
+	row, _ := PullRequestFilesUI.GetSelection()
+	row += delta
+	if row < 1 || row > len(PullRequestFilesUI.items) {
+		return
+	}
+	PullRequestFilesUI.Select(row, 0)
+}
+
+func updateDiffView(ui *SelectUI, row int) {
+	if row > 0 && row <= len(ui.items) {
+		file := ui.items[row-1].(*domain.PullRequestFile)
+		DiffViewUI.SetTitle(fmt.Sprintf("%s (%s)", file.Filename, file.Status))
+
+		currentFile = file
+		diffLines = github.ParsePatch(file.Patch)
+		diffCursor = 0
+		DiffViewUI.clearSearch()
+		updateDiffStatusLine()
+
+		patch := file.Patch
+		if patch == "" {
+			patch = "Binary file or diff too large to display."
+		}
+		UI.updater <- func() {
+			DiffViewUI.SetText(colorizeDiff(patch)).ScrollToBeginning()
+			DiffViewUI.Highlight(diffLineRegion(diffCursor))
+		}
+	}
+}
+
+// moveDiffCursor moves the review line cursor down (delta 1) or up (delta -1).
+func moveDiffCursor(delta int) {
+	cursor := diffCursor + delta
+	if cursor < 0 || cursor >= len(diffLines) {
+		return
+	}
+	diffCursor = cursor
+	DiffViewUI.Highlight(diffLineRegion(diffCursor)).ScrollToHighlight()
+	updateDiffStatusLine()
+}
+
+func diffLineRegion(index int) string {
+	return fmt.Sprintf("l%d", index)
+}
+
+func updateDiffStatusLine() {
+	pr := currentPullRequest
+
+	line := "-"
+	if diffCursor < len(diffLines) {
+		l := diffLines[diffCursor]
+		switch {
+		case l.Kind == domain.DiffLineDeleted:
+			line = fmt.Sprintf("L%d", l.OldLine)
+		case l.NewLine > 0:
+			line = fmt.Sprintf("R%d", l.NewLine)
+		}
+	}
+
+	diffStatusLine.SetText(fmt.Sprintf(
+		"PR #%s %s | Line: %s | Pending: %d | ]/[: file | J/K: line | c: comment | P: pending | S: submit | X: discard | /: search | n/N: match",
+		pr.Number, pr.Title, line, len(pendingReviews[pr.ID]),
+	))
+}
+
+// colorizeDiff colors added, deleted and hunk header lines of a unified diff
+// and wraps every line in a region so the review cursor can highlight it.
+func colorizeDiff(text string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		escaped := tview.Escape(line)
+		switch {
+		case strings.HasPrefix(line, "+"):
+			escaped = "[green]" + escaped + "[white]"
+		case strings.HasPrefix(line, "-"):
+			escaped = "[red]" + escaped + "[white]"
+		case strings.HasPrefix(line, "@@"):
+			escaped = "[darkcyan]" + escaped + "[white]"
+		}
+		lines[i] = fmt.Sprintf(`["%s"]%s[""]`, diffLineRegion(i), escaped)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// searchDiff finds the lines of the patch containing query and moves the
+// review cursor to the first of them.
+func searchDiff(query string) {
+	if currentFile == nil {
+		return
+	}
+	lines := strings.Split(currentFile.Patch, "\n")
+	DiffViewUI.searchLines(query, lines, fmt.Sprintf("%s (%s)", currentFile.Filename, currentFile.Status))
+}
+
+// showDiffLine moves the review cursor to a line of the patch.
+func showDiffLine(line int) {
+	if line < len(diffLines) {
+		diffCursor = line
+	}
+	DiffViewUI.Highlight(diffLineRegion(line)).ScrollToHighlight()
+	updateDiffStatusLine()
+}
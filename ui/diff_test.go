@@ -0,0 +1,44 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rivo/tview"
+)
+
+func TestColorizeDiff(t *testing.T) {
+	tests := []struct {
+		name  string
+		patch string
+	}{
+		{
+			name:  "plain lines",
+			patch: "@@ -1,2 +1,2 @@\n-old\n+new\n same",
+		},
+		{
+			name:  "lines with brackets",
+			patch: "@@ -1,3 +1,3 @@\n-  v := m[\"key\"]\n+  v := m[\"key\"][0]\n fmt.Println(\"[red]\", []string{})",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			view := tview.NewTextView().SetDynamicColors(true).SetRegions(true)
+			view.SetText(colorizeDiff(tt.patch))
+
+			if got := view.GetText(true); got != tt.patch {
+				t.Errorf("shown text = %q, want %q", got, tt.patch)
+			}
+
+			// every line is in its own region so the review cursor can highlight it
+			lines := strings.Split(tt.patch, "\n")
+			for i := range lines {
+				region := `["` + diffLineRegion(i) + `"]`
+				if !strings.Contains(colorizeDiff(tt.patch), region) {
+					t.Errorf("line %d is not in region %s", i, region)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,312 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/gdamore/tcell/v2"
+	gogithub "github.com/google/go-github/v68/github"
+	"github.com/rivo/tview"
+	"github.com/shurcooL/githubv4"
+	"github.com/skanehira/ght/config"
+	"github.com/skanehira/ght/domain"
+	"github.com/skanehira/ght/github"
+)
+
+// dispatchWorkflow fetches the workflow file and, if the workflow declares
+// workflow_dispatch, shows a form with its inputs to trigger it.
+func dispatchWorkflow(workflow *gogithub.Workflow) {
+	focus := func() {
+		UI.app.SetFocus(WorkflowRunsUI)
+	}
+
+	go func() {
+		ref := config.GitHub.Branch
+		if ref == "" {
+			ref = defaultBranch()
+		}
+
+		inputs, environments, err := getDispatchInputs(workflow, ref)
+		// the local branch may not have been pushed, so the workflow file is
+		// looked up on the default branch then
+		if isNotFoundError(err) && ref == config.GitHub.Branch {
+			if branch := defaultBranch(); branch != "" && branch != ref {
+				ref = branch
+				inputs, environments, err = getDispatchInputs(workflow, ref)
+			}
+		}
+		if err != nil {
+			UI.updater <- func() {
+				UI.Message(err.Error(), focus)
+			}
+			return
+		}
+
+		UI.updater <- func() {
+			dispatchWorkflowForm(workflow, ref, inputs, environments)
+		}
+	}()
+}
+
+// defaultBranch returns the default branch of the repository, or "" if it
+// cannot be fetched.
+func defaultBranch() string {
+	resp, err := github.GetRepo(map[string]interface{}{
+		"owner": githubv4.String(config.GitHub.Owner),
+		"name":  githubv4.String(config.GitHub.Repo),
+	})
+	if err != nil {
+		log.Println(err)
+		return ""
+	}
+	return string(resp.DefaultBranchRef.Name)
+}
+
+// getDispatchInputs parses the workflow file at the ref and returns its
+// workflow_dispatch inputs, and the environments of the repository if one of
+// the inputs is an environment.
+func getDispatchInputs(workflow *gogithub.Workflow, ref string) ([]*domain.WorkflowInput, []string, error) {
+	ctx := context.Background()
+	owner := config.GitHub.Owner
+	repo := config.GitHub.Repo
+
+	content, err := github.GetWorkflowFile(ctx, owner, repo, workflow.GetPath(), ref)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	inputs, dispatchable, err := github.ParseWorkflowDispatch([]byte(content))
+	if err != nil {
+		return nil, nil, err
+	}
+	if !dispatchable {
+		if ref == "" {
+			return nil, nil, fmt.Errorf("%s does not declare workflow_dispatch", workflow.GetName())
+		}
+		return nil, nil, fmt.Errorf("%s does not declare workflow_dispatch on %s", workflow.GetName(), ref)
+	}
+
+	// environments are only needed for environment inputs
+	var environments []string
+	for _, input := range inputs {
+		if input.Type != "environment" {
+			continue
+		}
+		envs, err := github.ListEnvironments(ctx, owner, repo)
+		if err != nil {
+			log.Println(err)
+		}
+		for _, env := range envs {
+			environments = append(environments, env.GetName())
+		}
+		break
+	}
+
+	return inputs, environments, nil
+}
+
+// dispatchWorkflowForm shows a form to pick a ref and fill in the inputs of a
+// workflow_dispatch trigger. The inputs are reloaded when the ref changes,
+// since the workflow file may declare other inputs on that ref.
+func dispatchWorkflowForm(workflow *gogithub.Workflow, ref string, inputs []*domain.WorkflowInput, environments []string) {
+	focus := func() {
+		UI.app.SetFocus(WorkflowRunsUI)
+	}
+	closeForm := func() {
+		UI.pages.RemovePage("dispatch").ShowPage("actions")
+		focus()
+	}
+	backToForm := func() {
+		UI.pages.SwitchToPage("dispatch").ShowPage("actions")
+	}
+
+	form := tview.NewForm()
+	form.SetBorder(true)
+	form.SetTitleAlign(tview.AlignLeft)
+
+	refInput := tview.NewInputField().SetLabel("Ref").SetText(ref)
+	go func() {
+		resp, err := github.GetRepoBranches(map[string]interface{}{
+			"owner":  githubv4.String(config.GitHub.Owner),
+			"name":   githubv4.String(config.GitHub.Repo),
+			"first":  githubv4.Int(100),
+			"cursor": (*githubv4.String)(nil),
+		})
+		if err != nil {
+			log.Println(err)
+			return
+		}
+
+		var branches []string
+		for _, ref := range resp.Nodes {
+			branches = append(branches, string(ref.Name))
+		}
+		refInput.SetAutocompleteFunc(func(text string) []string {
+			return branchAutocompleteFunc(text, branches)
+		})
+	}()
+
+	// loadedRef is the ref the inputs were parsed at, and values returns the
+	// current value of every input
+	loadedRef := ref
+	values := map[string]func() string{}
+
+	setInputs := func(newInputs []*domain.WorkflowInput, environments []string) {
+		inputs = newInputs
+		values = map[string]func() string{}
+
+		title := fmt.Sprintf("Run %s", workflow.GetName())
+		if loadedRef != "" {
+			title += fmt.Sprintf(" (inputs on %s)", loadedRef)
+		}
+		form.SetTitle(title)
+		form.Clear(false)
+		form.AddFormItem(refInput)
+		for _, input := range inputs {
+			values[input.Name] = addDispatchInput(form, input, environments)
+		}
+
+		height := 2*(len(inputs)+1) + 5
+		UI.pages.AddAndSwitchToPage("dispatch", UI.Modal(form, 100, height), true).ShowPage("actions")
+	}
+
+	reloadInputs := func(ref string) {
+		loadedRef = ref
+		go func() {
+			newInputs, environments, err := getDispatchInputs(workflow, ref)
+			UI.updater <- func() {
+				// the ref was changed again while loading
+				if refInput.GetText() != ref {
+					return
+				}
+				if err != nil {
+					UI.Message(err.Error(), backToForm)
+					return
+				}
+				setInputs(newInputs, environments)
+				form.SetFocus(1)
+				UI.app.SetFocus(form)
+			}
+		}()
+	}
+
+	refInput.SetDoneFunc(func(key tcell.Key) {
+		switch key {
+		case tcell.KeyEnter, tcell.KeyTab, tcell.KeyBacktab:
+			if ref := refInput.GetText(); ref != "" && ref != loadedRef {
+				reloadInputs(ref)
+			}
+		}
+	})
+
+	form.AddButton("Run", func() {
+		ref := refInput.GetText()
+		if ref == "" {
+			UI.Message("ref is required", backToForm)
+			return
+		}
+		// let the inputs of the new ref be checked before running
+		if ref != loadedRef {
+			reloadInputs(ref)
+			return
+		}
+
+		params := map[string]interface{}{}
+		for _, input := range inputs {
+			value := values[input.Name]()
+			if value == "" {
+				if input.Required {
+					UI.Message(fmt.Sprintf("%s is required", input.Name), backToForm)
+					return
+				}
+				continue
+			}
+			params[input.Name] = value
+		}
+
+		err := github.DispatchWorkflow(context.Background(), config.GitHub.Owner, config.GitHub.Repo, workflow.GetID(), ref, params)
+		if err != nil {
+			UI.Message(err.Error(), backToForm)
+			return
+		}
+
+		closeForm()
+		refreshAfterAction(WorkflowRunsUI)
+	})
+	form.AddButton("Cancel", closeForm)
+
+	form.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyCtrlN:
+			k := tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone)
+			UI.app.QueueEvent(k)
+		case tcell.KeyCtrlP:
+			k := tcell.NewEventKey(tcell.KeyBacktab, 0, tcell.ModNone)
+			UI.app.QueueEvent(k)
+		}
+		return event
+	})
+
+	setInputs(inputs, environments)
+}
+
+// addDispatchInput adds a form item for the input and returns a function
+// returning its value.
+func addDispatchInput(form *tview.Form, input *domain.WorkflowInput, environments []string) func() string {
+	label := input.Name
+	if input.Required {
+		label += " *"
+	}
+	if input.Description != "" {
+		label = fmt.Sprintf("%s (%s)", label, input.Description)
+	}
+
+	switch input.Type {
+	case "boolean":
+		checkbox := tview.NewCheckbox().SetLabel(label).SetChecked(input.Default == "true")
+		form.AddFormItem(checkbox)
+		return func() string {
+			return strconv.FormatBool(checkbox.IsChecked())
+		}
+	case "choice", "environment":
+		options := input.Options
+		if input.Type == "environment" {
+			options = environments
+		}
+		// fall back to free text when there is nothing to choose from
+		if len(options) == 0 {
+			return addDispatchInputField(form, label, input)
+		}
+
+		var value string
+		dropDown := tview.NewDropDown().SetLabel(label).
+			SetOptions(options, func(text string, index int) {
+				value = text
+			})
+		current := 0
+		for i, o := range options {
+			if o == input.Default {
+				current = i
+			}
+		}
+		dropDown.SetCurrentOption(current)
+		form.AddFormItem(dropDown)
+		return func() string {
+			return value
+		}
+	}
+	return addDispatchInputField(form, label, input)
+}
+
+// addDispatchInputField adds a text field for a string or number input and
+// returns a function returning its value.
+func addDispatchInputField(form *tview.Form, label string, input *domain.WorkflowInput) func() string {
+	field := tview.NewInputField().SetLabel(label).SetText(input.Default)
+	if input.Type == "number" {
+		field.SetAcceptanceFunc(tview.InputFieldFloat)
+	}
+	form.AddFormItem(field)
+	return field.GetText
+}
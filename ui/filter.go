@@ -5,7 +5,11 @@ import (
 	"github.com/rivo/tview"
 )
 
-var IssueFilterUI *FilterUI
+var (
+	IssueFilterUI       *FilterUI
+	PullRequestFilterUI *FilterUI
+	RunFilterUI         *FilterUI
+)
 
 type (
 	SetFilterOpt func(ui *FilterUI)
@@ -15,6 +19,27 @@ type (
 )
 
 func NewFilterUI() {
+	IssueFilterUI = newFilterUI(func() {
+		go IssueUI.GetList()
+	})
+}
+
+func NewPullRequestFilterUI() {
+	PullRequestFilterUI = newFilterUI(func() {
+		go PullRequestUI.GetList()
+	})
+}
+
+func NewRunFilterUI() {
+	RunFilterUI = newFilterUI(func() {
+		go WorkflowRunsUI.GetList()
+		WorkflowRunsUI.focus()
+		UI.app.SetFocus(WorkflowRunsUI)
+	})
+	RunFilterUI.SetPlaceholder("branch: actor: event: status: created:>=YYYY-MM-DD sha:")
+}
+
+func newFilterUI(search func()) *FilterUI {
 	ui := &FilterUI{
 		InputField: tview.NewInputField().SetLabel("Filters").SetLabelWidth(8),
 	}
@@ -23,11 +48,11 @@ func NewFilterUI() {
 	ui.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
 		switch event.Key() {
 		case tcell.KeyEnter:
-			go IssueUI.GetList()
+			search()
 		}
 		return event
 	})
-	IssueFilterUI = ui
+	return ui
 }
 
 func (ui *FilterUI) SetQuery(query string) {
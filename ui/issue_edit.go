@@ -0,0 +1,306 @@
+package ui
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/shurcooL/githubv4"
+	"github.com/skanehira/ght/domain"
+	"github.com/skanehira/ght/github"
+	"github.com/skanehira/ght/utils"
+)
+
+var issueStates = []string{"open", "closed as completed", "closed as not planned"}
+
+// issueStateOption returns the option of issueStates the issue is in.
+func issueStateOption(issue *domain.Issue) int {
+	if issue.State != "CLOSED" {
+		return 0
+	}
+	if issue.StateReason == string(github.IssueClosedStateReasonNotPlanned) {
+		return 2
+	}
+	return 1
+}
+
+// editIssueForm shows a form to edit the issue under the cursor, filled in
+// with its current title, body, state, milestone, labels and assignees.
+func editIssueForm() {
+	item := IssueUI.GetSelect()
+	if item == nil {
+		return
+	}
+	issue := item.(*domain.Issue)
+
+	focus := func() {
+		UI.app.SetFocus(IssueUI)
+	}
+
+	v := map[string]interface{}{
+		"owner":  githubv4.String(issue.RepoOwner),
+		"name":   githubv4.String(issue.Repo),
+		"first":  githubv4.Int(100),
+		"cursor": (*githubv4.String)(nil),
+	}
+
+	form := tview.NewForm()
+	form.SetBorder(true)
+	form.SetTitle("Edit issue #" + issue.Number)
+	form.SetTitleAlign(tview.AlignLeft)
+	labelWidth := 12
+
+	titleInput := tview.NewInputField().SetLabel("Title").SetLabelWidth(labelWidth).
+		SetText(issue.Title)
+	form.AddFormItem(titleInput)
+
+	stateDropDown := tview.NewDropDown().SetLabel("State").SetLabelWidth(labelWidth).
+		SetOptions(issueStates, nil).SetCurrentOption(issueStateOption(issue))
+	form.AddFormItem(stateDropDown)
+
+	// the current values of the issue are known before the options of the
+	// repository are fetched
+	var milestoneID string
+	milestoneTitle := "(none)"
+	if len(issue.MileStone) > 0 {
+		milestone := issue.MileStone[0].(*domain.Milestone)
+		milestoneID, milestoneTitle = milestone.ID, milestone.Title
+	}
+	milestoneDropDown := tview.NewDropDown().SetLabel("Milestone").SetLabelWidth(labelWidth).
+		SetOptions([]string{milestoneTitle}, nil).SetCurrentOption(0)
+	form.AddFormItem(milestoneDropDown)
+	go func() {
+		resp, err := github.GetRepoMillestones(v)
+		if err != nil {
+			log.Println(err)
+			return
+		}
+
+		titles := []string{"(none)"}
+		ids := []string{""}
+		current := 0
+		for _, milestone := range resp.Nodes {
+			id, _ := milestone.ID.(string)
+			titles = append(titles, string(milestone.Title))
+			ids = append(ids, id)
+			if id == milestoneID {
+				current = len(ids) - 1
+			}
+		}
+		// the milestone of the issue may be closed or not among the fetched
+		// ones, and would be removed if it was not an option
+		if milestoneID != "" && current == 0 {
+			titles = append(titles, milestoneTitle)
+			ids = append(ids, milestoneID)
+			current = len(ids) - 1
+		}
+		UI.app.QueueUpdateDraw(func() {
+			milestoneDropDown.SetOptions(titles, nil).SetCurrentOption(current)
+			// set after the current option, whose selection calls it
+			milestoneDropDown.SetSelectedFunc(func(text string, index int) {
+				milestoneID = ids[index]
+			})
+		})
+	}()
+
+	// labelMap and userMap hold the current labels and assignees of the issue
+	// until the ones of the repository are added on the UI goroutine
+	labelMap := map[string]githubv4.ID{}
+	var labelNames []string
+	for _, l := range issue.Labels {
+		label := l.(*domain.Label)
+		labelMap[label.Name] = label.ID
+		labelNames = append(labelNames, label.Name)
+	}
+	labelInput := tview.NewInputField().SetLabel("Labels").SetLabelWidth(labelWidth).
+		SetText(strings.Join(labelNames, ","))
+	form.AddFormItem(labelInput)
+	go func() {
+		resp, err := github.GetRepoLabels(v)
+		if err != nil {
+			log.Println(err)
+			return
+		}
+
+		UI.app.QueueUpdateDraw(func() {
+			var labels []string
+			for _, l := range resp.Nodes {
+				name := string(l.Name)
+				labelMap[name] = l.ID
+				labels = append(labels, name)
+			}
+			labelInput.SetAutocompleteFunc(func(text string) []string {
+				return autocompleteFunc(text, labels)
+			})
+		})
+	}()
+
+	userMap := map[string]githubv4.ID{}
+	var logins []string
+	for _, a := range issue.Assignees {
+		user := a.(*domain.AssignableUser)
+		userMap[user.Login] = user.ID
+		logins = append(logins, user.Login)
+	}
+	assigneesInput := tview.NewInputField().SetLabel("Assignees").SetLabelWidth(labelWidth).
+		SetText(strings.Join(logins, ","))
+	form.AddFormItem(assigneesInput)
+	go func() {
+		resp, err := github.GetRepoAssignableUsers(v)
+		if err != nil {
+			log.Println(err)
+			return
+		}
+
+		UI.app.QueueUpdateDraw(func() {
+			var users []string
+			for _, u := range resp.Nodes {
+				name := string(u.Login)
+				userMap[name] = u.ID
+				users = append(users, name)
+			}
+			assigneesInput.SetAutocompleteFunc(func(text string) []string {
+				return autocompleteFunc(text, users)
+			})
+		})
+	}()
+
+	closeForm := func() {
+		UI.pages.RemovePage("edit-issue").ShowPage("main")
+		focus()
+	}
+	backToForm := func() {
+		UI.pages.SwitchToPage("edit-issue").ShowPage("main")
+	}
+
+	body := issue.Body
+	var saving bool
+	form.AddButton("Edit Body", func() {
+		UI.app.Suspend(func() {
+			if err := utils.Edit(&body); err != nil {
+				log.Println(err)
+			}
+		})
+	})
+	form.AddButton("Save", func() {
+		if saving {
+			return
+		}
+		edit := &github.IssueEdit{
+			Title:       titleInput.GetText(),
+			Body:        body,
+			State:       "OPEN",
+			MilestoneID: milestoneID,
+		}
+		switch i, _ := stateDropDown.GetCurrentOption(); i {
+		case 1:
+			edit.State = "CLOSED"
+			edit.StateReason = github.IssueClosedStateReasonCompleted
+		case 2:
+			edit.State = "CLOSED"
+			edit.StateReason = github.IssueClosedStateReasonNotPlanned
+		}
+
+		if strings.TrimSpace(edit.Title) == "" {
+			UI.Message("title is empty", backToForm)
+			return
+		}
+		labelIDs, err := lookupNames(labelInput.GetText(), labelMap, "label")
+		if err != nil {
+			UI.Message(err.Error(), backToForm)
+			return
+		}
+		assigneeIDs, err := lookupNames(assigneesInput.GetText(), userMap, "user")
+		if err != nil {
+			UI.Message(err.Error(), backToForm)
+			return
+		}
+		for _, id := range labelIDs {
+			s, _ := id.(string)
+			edit.LabelIDs = append(edit.LabelIDs, s)
+		}
+		for _, id := range assigneeIDs {
+			s, _ := id.(string)
+			edit.AssigneeIDs = append(edit.AssigneeIDs, s)
+		}
+
+		update, changes := github.DiffIssue(issue, edit)
+		if update == nil && changes.Empty() {
+			closeForm()
+			return
+		}
+
+		saving = true
+		form.SetTitle("Saving issue #" + issue.Number + "...")
+		go func() {
+			err := github.EditIssue(context.Background(), issue.ID, update, changes)
+			// the issue may be changed partly when the edit failed
+			go refreshIssue(issue)
+			UI.updater <- func() {
+				saving = false
+				if err != nil {
+					form.SetTitle("Edit issue #" + issue.Number)
+					UI.Message(err.Error(), backToForm)
+					return
+				}
+				closeForm()
+			}
+		}()
+	})
+	form.AddButton("Cancel", closeForm)
+
+	form.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEsc:
+			closeForm()
+			return nil
+		case tcell.KeyCtrlN:
+			k := tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone)
+			UI.app.QueueEvent(k)
+		case tcell.KeyCtrlP:
+			k := tcell.NewEventKey(tcell.KeyBacktab, 0, tcell.ModNone)
+			UI.app.QueueEvent(k)
+		}
+		return event
+	})
+
+	UI.pages.AddAndSwitchToPage("edit-issue", UI.Modal(form, 100, 17), true).ShowPage("main")
+}
+
+// refreshIssue fetches the issue again and replaces its row and, if it is
+// under the cursor, its panes.
+func refreshIssue(issue *domain.Issue) {
+	number, err := strconv.Atoi(issue.Number)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	v := map[string]interface{}{
+		"owner":         githubv4.String(issue.RepoOwner),
+		"name":          githubv4.String(issue.Repo),
+		"number":        githubv4.Int(number),
+		"commentsFirst": githubv4.Int(commentsPageSize),
+	}
+	resp, err := github.GetIssue(v)
+	if err != nil {
+		UI.updater <- func() {
+			UI.Message(err.Error(), func() {
+				UI.app.SetFocus(IssueUI)
+			})
+		}
+		return
+	}
+
+	fresh := resp.ToDomain()
+	UI.app.QueueUpdateDraw(func() {
+		// the issue is updated in place so the panes read the fresh one
+		*issue = *fresh
+		IssueUI.UpdateItem(fresh)
+		IssueUI.UpdateView()
+		row, _ := IssueUI.GetSelection()
+		updateUIRelatedIssue(IssueUI, row)
+	})
+}
@@ -0,0 +1,345 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/skanehira/ght/domain"
+	"github.com/skanehira/ght/github"
+)
+
+// selectedIssuesRepo returns the selected issues and the repository they
+// belong to. Labels, milestones and the like only exist in one repository, so
+// issues of several repositories cannot be edited together.
+func selectedIssuesRepo(focus func()) ([]*domain.Issue, map[string]interface{}, bool) {
+	issues := getSelectedIssues()
+	if len(issues) == 0 {
+		return nil, nil, false
+	}
+
+	owner, repo := issues[0].RepoOwner, issues[0].Repo
+	for _, issue := range issues[1:] {
+		if issue.RepoOwner != owner || issue.Repo != repo {
+			UI.Message("the selected issues belong to different repositories", focus)
+			return nil, nil, false
+		}
+	}
+
+	v := map[string]interface{}{
+		"owner":  githubv4.String(owner),
+		"name":   githubv4.String(repo),
+		"first":  githubv4.Int(100),
+		"cursor": (*githubv4.String)(nil),
+	}
+	return issues, v, true
+}
+
+// pickForIssues fetches the options of the repository of the selected issues
+// with getOptions and lets the user pick from them.
+func pickForIssues(title string, multiple bool, focus func(),
+	getOptions func(v map[string]interface{}) ([]domain.Item, error),
+	done func(issues []*domain.Issue, picked []domain.Item)) {
+	issues, v, ok := selectedIssuesRepo(focus)
+	if !ok {
+		return
+	}
+
+	go func() {
+		options, err := getOptions(v)
+		if err != nil {
+			UI.updater <- func() {
+				UI.Message(err.Error(), focus)
+			}
+			return
+		}
+		UI.app.QueueUpdateDraw(func() {
+			showPicker(title, options, multiple, func(picked []domain.Item) {
+				done(issues, picked)
+			}, focus)
+		})
+	}()
+}
+
+// editIssues applies changes to the issues with bounded parallelism. update
+// then applies the changes to every issue that was changed, on the UI
+// thread, before the panes of the issue under the cursor are refreshed and
+// the issues that failed are reported.
+func editIssues(issues []*domain.Issue, focus func(), changes *github.IssueChangeSet, update func(issue *domain.Issue)) {
+	go func() {
+		ids := make([]string, len(issues))
+		for i, issue := range issues {
+			ids[i] = issue.ID
+		}
+		errs := github.ApplyIssueChangeSet(context.Background(), ids, changes)
+
+		UI.updater <- func() {
+			var failures []string
+			for i, issue := range issues {
+				if errs[i] != nil {
+					failures = append(failures, fmt.Sprintf("#%s: %s", issue.Number, errs[i]))
+					continue
+				}
+				update(issue)
+			}
+
+			row, _ := IssueUI.GetSelection()
+			updateUIRelatedIssue(IssueUI, row)
+			if len(failures) > 0 {
+				UI.Message(strings.Join(failures, "\n"), focus)
+			}
+		}
+	}()
+}
+
+// paneSelection returns the items selected in a pane of the issue, or the
+// item under the cursor if none is selected.
+func paneSelection(ui *SelectUI) []domain.Item {
+	var items []domain.Item
+	if len(ui.selected) == 0 {
+		if item := ui.GetSelect(); item != nil {
+			items = append(items, item)
+		}
+	} else {
+		for _, item := range ui.selected {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// withItems returns items with the added items that it does not contain yet.
+func withItems(items, added []domain.Item) []domain.Item {
+	result := append([]domain.Item{}, items...)
+	for _, item := range added {
+		if !hasItem(result, item.Key()) {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// hasItem reports whether items contains an item with key.
+func hasItem(items []domain.Item, key string) bool {
+	for _, item := range items {
+		if item.Key() == key {
+			return true
+		}
+	}
+	return false
+}
+
+// withoutItems returns items without the items whose key is in keys.
+func withoutItems(items []domain.Item, keys map[string]bool) []domain.Item {
+	var result []domain.Item
+	for _, item := range items {
+		if !keys[item.Key()] {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+func addIssueLabels() {
+	focus := func() {
+		UI.app.SetFocus(LabelUI)
+	}
+
+	getOptions := func(v map[string]interface{}) ([]domain.Item, error) {
+		resp, err := github.GetRepoLabels(v)
+		if err != nil {
+			return nil, err
+		}
+		options := make([]domain.Item, len(resp.Nodes))
+		for i, l := range resp.Nodes {
+			options[i] = l.ToDomain()
+		}
+		return options, nil
+	}
+
+	pickForIssues("Add labels", true, focus, getOptions, func(issues []*domain.Issue, picked []domain.Item) {
+		changes := &github.IssueChangeSet{}
+		for _, item := range picked {
+			changes.AddLabelIDs = append(changes.AddLabelIDs, githubv4.ID(item.(*domain.Label).ID))
+		}
+		editIssues(issues, focus, changes, func(issue *domain.Issue) {
+			issue.Labels = withItems(issue.Labels, picked)
+		})
+	})
+}
+
+func removeIssueLabels() {
+	focus := func() {
+		UI.app.SetFocus(LabelUI)
+	}
+
+	issues, _, ok := selectedIssuesRepo(focus)
+	if !ok {
+		return
+	}
+	removed := paneSelection(LabelUI)
+	if len(removed) == 0 {
+		return
+	}
+
+	changes := &github.IssueChangeSet{}
+	keys := map[string]bool{}
+	for _, item := range removed {
+		changes.RemoveLabelIDs = append(changes.RemoveLabelIDs, githubv4.ID(item.(*domain.Label).ID))
+		keys[item.Key()] = true
+	}
+	editIssues(issues, focus, changes, func(issue *domain.Issue) {
+		issue.Labels = withoutItems(issue.Labels, keys)
+	})
+}
+
+func addIssueAssignees() {
+	focus := func() {
+		UI.app.SetFocus(AssigneesUI)
+	}
+
+	getOptions := func(v map[string]interface{}) ([]domain.Item, error) {
+		resp, err := github.GetRepoAssignableUsers(v)
+		if err != nil {
+			return nil, err
+		}
+		options := make([]domain.Item, len(resp.Nodes))
+		for i, u := range resp.Nodes {
+			user := github.AssignableUser{ID: u.ID, Login: u.Login}
+			options[i] = user.ToDomain()
+		}
+		return options, nil
+	}
+
+	pickForIssues("Add assignees", true, focus, getOptions, func(issues []*domain.Issue, picked []domain.Item) {
+		changes := &github.IssueChangeSet{}
+		for _, item := range picked {
+			changes.AddAssigneeIDs = append(changes.AddAssigneeIDs, githubv4.ID(item.(*domain.AssignableUser).ID))
+		}
+		editIssues(issues, focus, changes, func(issue *domain.Issue) {
+			issue.Assignees = withItems(issue.Assignees, picked)
+		})
+	})
+}
+
+func removeIssueAssignees() {
+	focus := func() {
+		UI.app.SetFocus(AssigneesUI)
+	}
+
+	issues, _, ok := selectedIssuesRepo(focus)
+	if !ok {
+		return
+	}
+	removed := paneSelection(AssigneesUI)
+	if len(removed) == 0 {
+		return
+	}
+
+	changes := &github.IssueChangeSet{}
+	keys := map[string]bool{}
+	for _, item := range removed {
+		changes.RemoveAssigneeIDs = append(changes.RemoveAssigneeIDs, githubv4.ID(item.(*domain.AssignableUser).ID))
+		keys[item.Key()] = true
+	}
+	editIssues(issues, focus, changes, func(issue *domain.Issue) {
+		issue.Assignees = withoutItems(issue.Assignees, keys)
+	})
+}
+
+func setIssueMilestone() {
+	focus := func() {
+		UI.app.SetFocus(MilestoneUI)
+	}
+
+	getOptions := func(v map[string]interface{}) ([]domain.Item, error) {
+		resp, err := github.GetRepoMillestones(v)
+		if err != nil {
+			return nil, err
+		}
+		options := make([]domain.Item, len(resp.Nodes))
+		for i, m := range resp.Nodes {
+			options[i] = m.ToDomain()
+		}
+		return options, nil
+	}
+
+	pickForIssues("Set milestone", false, focus, getOptions, func(issues []*domain.Issue, picked []domain.Item) {
+		milestone := picked[0].(*domain.Milestone)
+		id := githubv4.ID(milestone.ID)
+		changes := &github.IssueChangeSet{MilestoneID: &id}
+		editIssues(issues, focus, changes, func(issue *domain.Issue) {
+			issue.MileStone = []domain.Item{milestone}
+		})
+	})
+}
+
+func removeIssueMilestone() {
+	focus := func() {
+		UI.app.SetFocus(MilestoneUI)
+	}
+
+	issues, _, ok := selectedIssuesRepo(focus)
+	if !ok {
+		return
+	}
+
+	changes := &github.IssueChangeSet{RemoveMilestone: true}
+	editIssues(issues, focus, changes, func(issue *domain.Issue) {
+		issue.MileStone = nil
+	})
+}
+
+func addIssueProjects() {
+	focus := func() {
+		UI.app.SetFocus(ProjectUI)
+	}
+
+	getOptions := func(v map[string]interface{}) ([]domain.Item, error) {
+		resp, err := github.GetRepoProjects(v)
+		if err != nil {
+			return nil, err
+		}
+		options := make([]domain.Item, len(resp.Nodes))
+		for i, p := range resp.Nodes {
+			options[i] = p.ToDomain()
+		}
+		return options, nil
+	}
+
+	pickForIssues("Add projects", true, focus, getOptions, func(issues []*domain.Issue, picked []domain.Item) {
+		changes := &github.IssueChangeSet{}
+		for _, item := range picked {
+			changes.AddProjectIDs = append(changes.AddProjectIDs, githubv4.ID(item.(*domain.Project).ID))
+		}
+		editIssues(issues, focus, changes, func(issue *domain.Issue) {
+			issue.Projects = withItems(issue.Projects, picked)
+		})
+	})
+}
+
+func removeIssueProjects() {
+	focus := func() {
+		UI.app.SetFocus(ProjectUI)
+	}
+
+	issues, _, ok := selectedIssuesRepo(focus)
+	if !ok {
+		return
+	}
+	removed := paneSelection(ProjectUI)
+	if len(removed) == 0 {
+		return
+	}
+
+	changes := &github.IssueChangeSet{}
+	keys := map[string]bool{}
+	for _, item := range removed {
+		changes.RemoveProjectIDs = append(changes.RemoveProjectIDs, githubv4.ID(item.(*domain.Project).ID))
+		keys[item.Key()] = true
+	}
+	editIssues(issues, focus, changes, func(issue *domain.Issue) {
+		issue.Projects = withoutItems(issue.Projects, keys)
+	})
+}
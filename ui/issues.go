@@ -1,6 +1,7 @@
 package ui
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -49,9 +50,10 @@ func NewIssueUI() {
 			IssueFilterUI.SetQuery(query)
 
 			v := map[string]interface{}{
-				"query":  githubv4.String(query),
-				"first":  githubv4.Int(30),
-				"cursor": (*githubv4.String)(cursor),
+				"query":         githubv4.String(query),
+				"first":         githubv4.Int(30),
+				"cursor":        (*githubv4.String)(cursor),
+				"commentsFirst": githubv4.Int(commentsPageSize),
 			}
 			resp, err := github.GetIssues(v)
 			if err != nil {
@@ -78,6 +80,14 @@ func NewIssueUI() {
 				createIssueForm()
 			case 'e':
 				editIssue()
+			case 'E':
+				editIssueForm()
+			case 't':
+				triageForm()
+			case 'T':
+				if item := IssueUI.GetSelect(); item != nil {
+					openTimeline(item.(*domain.Issue))
+				}
 			}
 			switch event.Key() {
 			case tcell.KeyCtrlO:
@@ -238,37 +248,6 @@ func createIssueForm() {
 
 	form.SetFocus(1)
 
-	// autocomplete for assignees, labels, projects, milestones
-	autocompleteFunc := func(text string, items []string) []string {
-		if text == "" {
-			return nil
-		}
-
-		words := strings.Split(text, ",")
-		word := words[len(words)-1]
-
-		var results []string
-		for _, l := range items {
-			var isDuplicate bool
-			for _, w := range words[:len(words)-1] {
-				if w == l {
-					isDuplicate = true
-					break
-				}
-			}
-
-			if isDuplicate {
-				continue
-			}
-
-			if strings.Contains(strings.ToLower(l), strings.ToLower(word)) {
-				words = append(words[:len(words)-1], l)
-				results = append(results, strings.Join(words, ","))
-			}
-		}
-		return results
-	}
-
 	// graphql query variables
 	v := map[string]interface{}{
 		"owner":  githubv4.String(owner),
@@ -519,6 +498,50 @@ func createIssueForm() {
 	UI.pages.AddAndSwitchToPage("form", UI.Modal(form, 100, 19), true).ShowPage("main")
 }
 
+// autocompleteFunc completes the last word of a comma separated list of
+// assignees, labels, projects or milestones
+func autocompleteFunc(text string, items []string) []string {
+	if text == "" {
+		return nil
+	}
+
+	words := strings.Split(text, ",")
+	word := words[len(words)-1]
+
+	var results []string
+	for _, l := range items {
+		var isDuplicate bool
+		for _, w := range words[:len(words)-1] {
+			if w == l {
+				isDuplicate = true
+				break
+			}
+		}
+
+		if isDuplicate {
+			continue
+		}
+
+		if strings.Contains(strings.ToLower(l), strings.ToLower(word)) {
+			words = append(words[:len(words)-1], l)
+			results = append(results, strings.Join(words, ","))
+		}
+	}
+	return results
+}
+
+// splitNames returns the IDs of the comma separated names in text.
+func splitNames(text string, ids map[string]githubv4.ID) []githubv4.ID {
+	var result []githubv4.ID
+	for _, name := range strings.Split(text, ",") {
+		if name == "" {
+			continue
+		}
+		result = append(result, ids[name])
+	}
+	return result
+}
+
 func editIssue() {
 	item := IssueUI.GetSelect()
 	if item == nil {
@@ -549,7 +572,7 @@ func editIssue() {
 		ID:   githubv4.ID(issue.ID),
 		Body: githubv4.NewString(githubv4.String(issue.Body)),
 	}
-	if err := github.UpdateIssue(input); err != nil {
+	if err := github.UpdateIssue(context.Background(), input); err != nil {
 		UI.Message(err.Error(), focus)
 		return
 	}
@@ -562,13 +585,7 @@ func updateUIRelatedIssue(ui *SelectUI, row int) {
 		issue := ui.items[row-1].(*domain.Issue)
 		IssueViewUI.updateView(issue.Body)
 
-		if len(issue.Comments) > 0 {
-			CommentUI.SetList(issue.Comments)
-			CommentViewUI.updateView(issue.Comments[0].(*domain.Comment).Body)
-		} else {
-			CommentUI.ClearView()
-			CommentViewUI.Clear()
-		}
+		setComments(issue)
 
 		if len(issue.Assignees) > 0 {
 			AssigneesUI.SetList(issue.Assignees)
@@ -34,6 +34,12 @@ func NewLabelsUI() {
 
 	setOpt := func(ui *SelectUI) {
 		ui.capture = func(event *tcell.EventKey) *tcell.EventKey {
+			switch event.Rune() {
+			case 'a':
+				addIssueLabels()
+			case 'd':
+				removeIssueLabels()
+			}
 			return event
 		}
 	}
@@ -0,0 +1,321 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/atotto/clipboard"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/skanehira/ght/config"
+	"github.com/skanehira/ght/domain"
+	"github.com/skanehira/ght/github"
+	"github.com/skanehira/ght/utils"
+)
+
+// logPageLines is the number of lines of a disk-backed log shown at once.
+const logPageLines = 2000
+
+var (
+	// logJob is the job whose log is shown in the full-screen preview,
+	// logAnnotations are the errors, warnings and notices of the log,
+	// logErrorLines the indexes of its error lines and logErrorIndex the
+	// error that was jumped to last.
+	logJob         *domain.WorkflowJob
+	logAnnotations []*domain.Annotation
+	logErrorLines  []int
+	logErrorIndex  int
+
+	// logStore is the log that is shown page by page, starting at line
+	// logOffset. It is nil while the log of a running job is followed, and
+	// logText is the log shown once that job completed.
+	logStore  *github.LogStore
+	logOffset int
+	logText   string
+)
+
+// colorizeLog colors the error, warning and notice lines of a job log and
+// wraps them, and the lines containing query, in a region so they can be
+// jumped to. The log text is escaped so it is not read as color tags.
+func colorizeLog(text, query string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		level := github.LogLineLevel(line)
+		hit := query != "" && strings.Contains(line, query)
+		line = tview.Escape(line)
+
+		if color := logLevelColor(level); color != "" {
+			line = fmt.Sprintf("[%s]%s[white]", color, line)
+		} else if !hit {
+			lines[i] = line
+			continue
+		}
+		lines[i] = fmt.Sprintf(`["%s"]%s[""]`, logLineRegion(logOffset+i), line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func logLevelColor(level string) string {
+	switch level {
+	case "error":
+		return "red"
+	case "warning":
+		return "yellow"
+	case "notice":
+		return "darkcyan"
+	}
+	return ""
+}
+
+func logLineRegion(index int) string {
+	return fmt.Sprintf("log%d", index)
+}
+
+// enableJobLogKeys makes the full-screen preview, which shows the log of job,
+// keep its colors when searching and adds keys to jump between its errors.
+func enableJobLogKeys(job *domain.WorkflowJob, annotations []*domain.Annotation) {
+	logJob = job
+	logAnnotations = annotations
+	logErrorLines = nil
+	logErrorIndex = -1
+	for _, a := range annotations {
+		if a.Level == "error" {
+			logErrorLines = append(logErrorLines, a.LogLine)
+		}
+	}
+
+	CommonViewUI.search = searchJobLog
+	CommonViewUI.showLine = showLogLine
+	CommonViewUI.capture = func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Rune() {
+		case ']':
+			jumpToLogError(1)
+		case '[':
+			jumpToLogError(-1)
+		case 'a':
+			showLogAnnotations()
+		}
+
+		if logStore == nil {
+			return event
+		}
+		switch event.Rune() {
+		case '>':
+			showLogPage(logOffset + logPageLines)
+		case '<':
+			showLogPage(logOffset - logPageLines)
+		}
+		return event
+	}
+}
+
+// showJobLogStore shows the disk-backed log of job page by page in the
+// full-screen preview.
+func showJobLogStore(job *domain.WorkflowJob, store *github.LogStore, annotations []*domain.Annotation) {
+	closeLogStore()
+	logStore = store
+	logOffset = 0
+
+	// the log is not needed once the preview is closed
+	UI.FullScreenPreview("", func() {
+		if logStore == store {
+			closeLogStore()
+		}
+		focusJobLogParent()
+	})
+	enableJobLogKeys(job, annotations)
+	showLogPage(0)
+}
+
+// showJobLogText colors the complete log of a followed job in the full-screen
+// preview and enables its keys.
+func showJobLogText(job *domain.WorkflowJob, text string) {
+	logText = text
+	CommonViewUI.clearSearch()
+
+	CommonViewUI.SetText(colorizeLog(text, ""))
+	enableJobLogKeys(job, github.ParseLogAnnotations(text))
+}
+
+// closeLogStore closes the disk-backed log shown last, if any.
+func closeLogStore() {
+	if logStore == nil {
+		return
+	}
+	if err := logStore.Close(); err != nil {
+		log.Println(err)
+	}
+	logStore = nil
+	logOffset = 0
+}
+
+// showLogPage shows the page of the disk-backed log starting at line offset.
+func showLogPage(offset int) {
+	if offset >= logStore.Len() {
+		return
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	lines, err := logStore.Lines(offset, logPageLines)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	logOffset = offset
+
+	title := fmt.Sprintf("%s (lines %d-%d of %d, </>: page)", logJob.Name, offset+1, offset+len(lines), logStore.Len())
+	CommonViewUI.SetTitle(CommonViewUI.searchTitle(title))
+	CommonViewUI.SetText(colorizeLog(strings.Join(lines, "\n"), CommonViewUI.searchQuery)).ScrollToBeginning()
+}
+
+// showLogLine highlights a line of the log, turning the page of a disk-backed
+// log if the line is not on the current one.
+func showLogLine(line int) {
+	if logStore != nil && (line < logOffset || line >= logOffset+logPageLines) {
+		showLogPage(line / logPageLines * logPageLines)
+	}
+	CommonViewUI.Highlight(logLineRegion(line)).ScrollToHighlight()
+}
+
+// jumpToLogError highlights the next (delta 1) or previous (delta -1) error line.
+func jumpToLogError(delta int) {
+	n := len(logErrorLines)
+	if n == 0 {
+		return
+	}
+	logErrorIndex = (logErrorIndex + delta + n) % n
+	showLogLine(logErrorLines[logErrorIndex])
+}
+
+// searchJobLog searches the whole log, not only the page shown of a
+// disk-backed log, and jumps to the first line containing query.
+func searchJobLog(query string) {
+	store := logStore
+	if store == nil {
+		// the hits become regions before they are jumped to
+		CommonViewUI.SetText(colorizeLog(logText, query))
+		CommonViewUI.searchLines(query, strings.Split(logText, "\n"), logJob.Name)
+		return
+	}
+
+	go func() {
+		hits, err := store.Search(query)
+		if err != nil {
+			log.Println(err)
+			return
+		}
+		UI.app.QueueUpdateDraw(func() {
+			// another log may be shown by now
+			if logStore != store {
+				return
+			}
+			CommonViewUI.setSearchHits(query, hits)
+			// re-render the page so its hits become regions
+			showLogPage(logOffset)
+			CommonViewUI.jumpToSearchHit(1)
+		})
+	}()
+}
+
+// showLogAnnotations lists the annotations found in the log together with the
+// annotations of the job's check run.
+func showLogAnnotations() {
+	job := logJob
+	annotations := append([]*domain.Annotation{}, logAnnotations...)
+
+	list := tview.NewList().ShowSecondaryText(false)
+	list.SetBorder(true).SetTitle("Annotations (Enter: jump, y: yank file:line, Ctrl+O: browser)").SetTitleAlign(tview.AlignLeft)
+
+	addItems := func(annotations []*domain.Annotation) {
+		for _, a := range annotations {
+			text := fmt.Sprintf("[%s]%s[white] %s %s", logLevelColor(a.Level), a.Level,
+				tview.Escape(a.Location()), tview.Escape(firstLine(a.Message)))
+			list.AddItem(text, "", 0, nil)
+		}
+	}
+	addItems(annotations)
+
+	go func() {
+		checkRunAnnotations, err := github.ListCheckRunAnnotations(context.Background(), config.GitHub.Owner, config.GitHub.Repo, job.ID)
+		if err != nil {
+			log.Println(err)
+			return
+		}
+		UI.app.QueueUpdateDraw(func() {
+			annotations = append(annotations, checkRunAnnotations...)
+			addItems(checkRunAnnotations)
+		})
+	}()
+
+	closeList := func() {
+		UI.pages.RemovePage("annotations").ShowPage("fullScreenPreview")
+		UI.app.SetFocus(CommonViewUI)
+	}
+
+	list.SetSelectedFunc(func(index int, mainText, secondaryText string, shortcut rune) {
+		a := annotations[index]
+		if a.LogLine < 0 {
+			openAnnotation(job, a)
+			return
+		}
+		closeList()
+		showLogLine(a.LogLine)
+	})
+
+	list.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEsc:
+			closeList()
+			return nil
+		case tcell.KeyCtrlO:
+			if len(annotations) > 0 {
+				openAnnotation(job, annotations[list.GetCurrentItem()])
+			}
+			return nil
+		}
+		switch event.Rune() {
+		case 'y':
+			if len(annotations) > 0 {
+				a := annotations[list.GetCurrentItem()]
+				text := a.Location()
+				if text == "" {
+					text = a.Message
+				}
+				if err := clipboard.WriteAll(text); err != nil {
+					log.Println(err)
+				}
+			}
+			return nil
+		}
+		return event
+	})
+
+	UI.pages.AddAndSwitchToPage("annotations", UI.Modal(list, 120, 20), true).ShowPage("fullScreenPreview")
+}
+
+// openAnnotation opens the file and line of an annotation at the job's commit in the browser.
+func openAnnotation(job *domain.WorkflowJob, a *domain.Annotation) {
+	// the job's page is under the repository's, on whichever host it is
+	i := strings.Index(job.HTMLURL, "/actions/")
+	if a.Path == "" || job.HeadSHA == "" || i < 0 {
+		return
+	}
+	url := fmt.Sprintf("%s/blob/%s/%s", job.HTMLURL[:i], job.HeadSHA, a.Path)
+	if a.Line > 0 {
+		url += fmt.Sprintf("#L%d", a.Line)
+	}
+	if err := utils.Open(url); err != nil {
+		log.Println(err)
+	}
+}
+
+func firstLine(s string) string {
+	if i := strings.Index(s, "\n"); i >= 0 {
+		return s[:i]
+	}
+	return s
+}
@@ -0,0 +1,67 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rivo/tview"
+)
+
+func TestColorizeLog(t *testing.T) {
+	tests := []struct {
+		name        string
+		text        string
+		query       string
+		wantRegions []int
+	}{
+		{
+			name: "plain lines",
+			text: "Run go test ./...\nok",
+		},
+		{
+			name:        "markers",
+			text:        "##[group]Run go test ./...\n##[error]Process completed with exit code 1.\n##[endgroup]",
+			wantRegions: []int{1},
+		},
+		{
+			name:        "lines with brackets",
+			text:        "fmt.Println(\"[red]\", []string{})\n##[warning]deprecated: use m[\"key\"][0]\n[\"log0\"]not a region[\"\"]",
+			wantRegions: []int{1},
+		},
+		{
+			name:        "search hits",
+			text:        "--- FAIL: TestParse [0.01s]\nok\n--- FAIL: TestLint",
+			query:       "FAIL",
+			wantRegions: []int{0, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logOffset = 0
+
+			colored := colorizeLog(tt.text, tt.query)
+			view := tview.NewTextView().SetDynamicColors(true).SetRegions(true)
+			view.SetText(colored)
+
+			if got := view.GetText(true); got != tt.text {
+				t.Errorf("shown text = %q, want %q", got, tt.text)
+			}
+
+			var got []int
+			for i := range strings.Split(tt.text, "\n") {
+				if strings.Contains(colored, `["`+logLineRegion(i)+`"]`) {
+					got = append(got, i)
+				}
+			}
+			if len(got) != len(tt.wantRegions) {
+				t.Fatalf("lines in regions = %v, want %v", got, tt.wantRegions)
+			}
+			for i := range got {
+				if got[i] != tt.wantRegions[i] {
+					t.Errorf("lines in regions = %v, want %v", got, tt.wantRegions)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package ui
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/shurcooL/githubv4"
+	"github.com/skanehira/ght/domain"
+	"github.com/skanehira/ght/github"
+	"github.com/skanehira/ght/utils"
+)
+
+// mergePullRequestForm shows a form to choose the merge method, commit message
+// and whether to delete the head branch, then asks for confirmation with the
+// pull request's merge status before merging it.
+func mergePullRequestForm() {
+	item := PullRequestUI.GetSelect()
+	if item == nil {
+		return
+	}
+	pr := item.(*domain.PullRequest)
+
+	focus := func() {
+		UI.app.SetFocus(PullRequestUI)
+	}
+	closeForm := func() {
+		UI.pages.RemovePage("merge").ShowPage("pulls")
+		focus()
+	}
+
+	form := tview.NewForm()
+	form.SetBorder(true)
+	form.SetTitle(fmt.Sprintf("Merge #%s", pr.Number))
+	form.SetTitleAlign(tview.AlignLeft)
+	inputWidth := 70
+
+	methods := []githubv4.PullRequestMergeMethod{
+		githubv4.PullRequestMergeMethodMerge,
+		githubv4.PullRequestMergeMethodSquash,
+		githubv4.PullRequestMergeMethodRebase,
+	}
+	method := methods[0]
+	methodDropDown := tview.NewDropDown().SetLabel("Method").SetLabelWidth(inputWidth).
+		SetOptions([]string{"Create a merge commit", "Squash and merge", "Rebase and merge"}, func(text string, index int) {
+			method = methods[index]
+		}).
+		SetCurrentOption(0)
+	form.AddFormItem(methodDropDown)
+
+	titleInput := tview.NewInputField().SetLabel("Commit title").SetLabelWidth(inputWidth).
+		SetText(fmt.Sprintf("%s (#%s)", pr.Title, pr.Number))
+	form.AddFormItem(titleInput)
+
+	deleteBranchCheckbox := tview.NewCheckbox().SetLabel("Delete head branch").SetLabelWidth(inputWidth)
+	form.AddFormItem(deleteBranchCheckbox)
+
+	var commitBody string
+	form.AddButton("Edit Body", func() {
+		UI.app.Suspend(func() {
+			if err := utils.Edit(&commitBody); err != nil {
+				log.Println(err)
+				return
+			}
+		})
+	})
+	form.AddButton("Merge", func() {
+		number, err := strconv.Atoi(pr.Number)
+		if err != nil {
+			UI.pages.RemovePage("merge").ShowPage("pulls")
+			UI.Message(err.Error(), focus)
+			return
+		}
+
+		status, err := github.GetPullRequestMergeStatus(map[string]interface{}{
+			"owner":  githubv4.String(pr.RepoOwner),
+			"name":   githubv4.String(pr.Repo),
+			"number": githubv4.Int(number),
+		})
+		if err != nil {
+			UI.Message(err.Error(), func() {
+				UI.pages.SwitchToPage("merge").ShowPage("pulls")
+			})
+			return
+		}
+
+		input := githubv4.MergePullRequestInput{
+			PullRequestID:   status.ID,
+			MergeMethod:     &method,
+			ExpectedHeadOid: &status.HeadRefOid,
+		}
+		if title := titleInput.GetText(); title != "" {
+			input.CommitHeadline = githubv4.NewString(githubv4.String(title))
+		}
+		if commitBody != "" {
+			input.CommitBody = githubv4.NewString(githubv4.String(commitBody))
+		}
+		deleteBranch := deleteBranchCheckbox.IsChecked()
+
+		UI.pages.RemovePage("merge").ShowPage("pulls")
+		UI.Confirm(mergeSummary(pr, status, method), "Merge", func() error {
+			if err := github.MergePullRequest(input); err != nil {
+				return err
+			}
+
+			if deleteBranch && status.HeadRef != nil {
+				if err := github.DeleteRef(status.HeadRef.ID); err != nil {
+					return err
+				}
+			}
+
+			go func() {
+				time.Sleep(1 * time.Second)
+				PullRequestUI.GetList()
+			}()
+			return nil
+		}, focus)
+	})
+	form.AddButton("Cancel", closeForm)
+
+	form.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyCtrlN:
+			k := tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone)
+			UI.app.QueueEvent(k)
+		case tcell.KeyCtrlP:
+			k := tcell.NewEventKey(tcell.KeyBacktab, 0, tcell.ModNone)
+			UI.app.QueueEvent(k)
+		}
+		return event
+	})
+
+	UI.pages.AddAndSwitchToPage("merge", UI.Modal(form, 100, 11), true).ShowPage("pulls")
+}
+
+// mergeSummary describes the mergeability, review decision and required
+// status checks of a pull request for the merge confirmation.
+func mergeSummary(pr *domain.PullRequest, status *github.PullRequestMergeStatus, method githubv4.PullRequestMergeMethod) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Merge #%s into %s (%s)?\n\n", pr.Number, pr.BaseRef, strings.ToLower(string(method)))
+	fmt.Fprintf(&b, "Mergeable: %s (%s)\n", orNone(string(status.Mergeable)), orNone(string(status.MergeStateStatus)))
+	fmt.Fprintf(&b, "Review decision: %s\n", orNone(string(status.ReviewDecision)))
+
+	state, checks := status.StatusChecks()
+	fmt.Fprintf(&b, "Status checks: %s\n", orNone(state))
+
+	var required []string
+	for _, c := range checks {
+		if c.IsRequired {
+			required = append(required, fmt.Sprintf("%s: %s", c.Name, c.State))
+		}
+	}
+	if len(required) > 0 {
+		fmt.Fprintf(&b, "Required checks:\n%s", strings.Join(required, "\n"))
+	} else {
+		b.WriteString("Required checks: none")
+	}
+	return b.String()
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "none"
+	}
+	return s
+}
@@ -56,6 +56,12 @@ func NewMilestoneUI() {
 					}
 				}
 			}
+			switch event.Rune() {
+			case 'a':
+				setIssueMilestone()
+			case 'd':
+				removeIssueMilestone()
+			}
 			return event
 		}
 	}
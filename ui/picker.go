@@ -0,0 +1,74 @@
+package ui
+
+import (
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/skanehira/ght/domain"
+)
+
+// showPicker shows a list of items on top of the main page to pick from.
+// When multiple is true, Space marks items and Enter picks the marked items,
+// or the item under the cursor if none is marked.
+func showPicker(title string, items []domain.Item, multiple bool, done func(picked []domain.Item), focus func()) {
+	if len(items) == 0 {
+		UI.Message("nothing to pick from", focus)
+		return
+	}
+
+	marked := make([]bool, len(items))
+	itemText := func(i int) string {
+		text := tview.Escape(items[i].Fields()[0].Text)
+		if !multiple {
+			return text
+		}
+		if marked[i] {
+			return selected + " " + text
+		}
+		return unselected + " " + text
+	}
+
+	list := tview.NewList().ShowSecondaryText(false)
+	if multiple {
+		title += " (Space: mark, Enter: pick)"
+	}
+	list.SetBorder(true).SetTitle(title).SetTitleAlign(tview.AlignLeft)
+	for i := range items {
+		list.AddItem(itemText(i), "", 0, nil)
+	}
+
+	closePicker := func() {
+		UI.pages.RemovePage("picker").ShowPage("main")
+		focus()
+	}
+
+	list.SetSelectedFunc(func(index int, mainText, secondaryText string, shortcut rune) {
+		var picked []domain.Item
+		for i, m := range marked {
+			if m {
+				picked = append(picked, items[i])
+			}
+		}
+		if len(picked) == 0 {
+			picked = append(picked, items[index])
+		}
+		closePicker()
+		done(picked)
+	})
+
+	list.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEsc:
+			closePicker()
+			return nil
+		}
+		if multiple && event.Rune() == ' ' {
+			i := list.GetCurrentItem()
+			marked[i] = !marked[i]
+			list.SetItemText(i, itemText(i), "")
+			return nil
+		}
+		return event
+	})
+
+	UI.pages.AddAndSwitchToPage("picker", UI.Modal(list, 60, 20), true).ShowPage("main")
+}
@@ -55,6 +55,12 @@ func NewProjectUI() {
 					}
 				}
 			}
+			switch event.Rune() {
+			case 'a':
+				addIssueProjects()
+			case 'd':
+				removeIssueProjects()
+			}
 			return event
 		}
 	}
@@ -0,0 +1,336 @@
+package ui
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/atotto/clipboard"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/shurcooL/githubv4"
+	"github.com/skanehira/ght/config"
+	"github.com/skanehira/ght/domain"
+	"github.com/skanehira/ght/github"
+	"github.com/skanehira/ght/utils"
+)
+
+var (
+	PullRequestUI *SelectUI
+
+	pullRequestFocus *focusRing
+)
+
+// NewPullRequestsUI creates the pull requests page with a filter, a pull request list and a preview.
+func NewPullRequestsUI() tview.Primitive {
+	opt := func(ui *SelectUI) {
+		// initial query
+		queries := []string{
+			fmt.Sprintf("repo:%s/%s", config.GitHub.Owner, config.GitHub.Repo),
+			"state:open",
+		}
+
+		PullRequestFilterUI.SetQuery(strings.Join(queries, " "))
+
+		ui.getList = func(cursor *string) ([]domain.Item, *github.PageInfo) {
+			var queries []string
+			query := PullRequestFilterUI.GetQuery()
+
+			if !strings.Contains(query, "is:pr") {
+				queries = append(queries, "is:pr")
+			}
+
+			for _, q := range strings.Split(query, " ") {
+				// exclude issues
+				if strings.Contains(q, "type:issue") || strings.Contains(q, "is:issue") {
+					continue
+				}
+
+				queries = append(queries, q)
+			}
+			query = strings.Join(queries, " ")
+			PullRequestFilterUI.SetQuery(query)
+
+			v := map[string]interface{}{
+				"query":  githubv4.String(query),
+				"first":  githubv4.Int(30),
+				"cursor": (*githubv4.String)(cursor),
+			}
+			resp, err := github.GetPullRequests(v)
+			if err != nil {
+				log.Println(err)
+				return nil, nil
+			}
+
+			pullRequests := make([]domain.Item, len(resp.Nodes))
+			for i, node := range resp.Nodes {
+				pullRequests[i] = node.PullRequest.ToDomain()
+			}
+			return pullRequests, &resp.PageInfo
+		}
+
+		ui.capture = func(event *tcell.EventKey) *tcell.EventKey {
+			switch event.Rune() {
+			case 'y':
+				yankPullRequestURLs()
+			case 'd':
+				if item := PullRequestUI.GetSelect(); item != nil {
+					openPullRequestDiff(item.(*domain.PullRequest))
+				}
+			case 'm':
+				mergePullRequestForm()
+			case 's':
+				if item := PullRequestUI.GetSelect(); item != nil {
+					openStatusChecks(item.(*domain.PullRequest))
+				}
+			case 'n':
+				createPullRequestForm()
+			case 'c':
+				go closePullRequests()
+			case 'o':
+				go openPullRequests()
+			case 'b':
+				changeBaseForm()
+			case 'R':
+				go markPullRequestsReadyForReview()
+			case 'D':
+				go convertPullRequestsToDraft()
+			}
+			switch event.Key() {
+			case tcell.KeyCtrlO:
+				openPullRequestsBrowser()
+			}
+
+			return event
+		}
+
+		ui.header = []string{
+			"",
+			"Number",
+			"State",
+			"Author",
+			"Branch",
+			"Draft",
+			"Review",
+			"Mergeable",
+			"Title",
+		}
+
+		ui.hasHeader = len(ui.header) > 0
+	}
+
+	ui := NewSelectListUI(UIKindPullRequest, tcell.ColorBlue, opt)
+
+	ui.SetSelectionChangedFunc(func(row, col int) {
+		updateUIRelatedPullRequest(ui, row)
+	})
+
+	PullRequestUI = ui
+
+	pullRequestFocus = &focusRing{
+		primitives: []Primitive{PullRequestFilterUI, PullRequestUI, PullRequestViewUI},
+		current:    1,
+	}
+
+	grid := tview.NewGrid().SetRows(1, 0, 0).
+		AddItem(PullRequestFilterUI, 0, 0, 1, 1, 0, 0, true).
+		AddItem(PullRequestUI, 1, 0, 1, 1, 0, 0, true).
+		AddItem(PullRequestViewUI, 2, 0, 1, 1, 0, 0, true)
+
+	return grid
+}
+
+func getSelectedPullRequests() []*domain.PullRequest {
+	var pullRequests []*domain.PullRequest
+	if len(PullRequestUI.selected) == 0 {
+		data := PullRequestUI.GetSelect()
+		if data != nil {
+			pullRequests = append(pullRequests, data.(*domain.PullRequest))
+		}
+	} else {
+		for _, item := range PullRequestUI.selected {
+			pullRequests = append(pullRequests, item.(*domain.PullRequest))
+		}
+	}
+	return pullRequests
+}
+
+func yankPullRequestURLs() {
+	var urls []string
+	for _, pr := range getSelectedPullRequests() {
+		urls = append(urls, pr.URL)
+	}
+
+	url := strings.Join(urls, "\n")
+	if err := clipboard.WriteAll(url); err != nil {
+		log.Println(err)
+	}
+	PullRequestUI.ClearSelected()
+	PullRequestUI.UpdateView()
+}
+
+func openPullRequestsBrowser() {
+	for _, pr := range getSelectedPullRequests() {
+		if err := utils.Open(pr.URL); err != nil {
+			log.Println(err)
+		}
+	}
+	PullRequestUI.ClearSelected()
+	PullRequestUI.UpdateView()
+}
+
+// updatePullRequests runs update on every selected pull request in parallel
+// and redraws the list when all of them are done.
+func updatePullRequests(update func(pr *domain.PullRequest) error) {
+	var wg sync.WaitGroup
+	for _, pr := range getSelectedPullRequests() {
+		wg.Add(1)
+		go func(pr *domain.PullRequest) {
+			defer wg.Done()
+			if err := update(pr); err != nil {
+				log.Println(err)
+			}
+		}(pr)
+	}
+	wg.Wait()
+	PullRequestUI.ClearSelected()
+	PullRequestUI.UpdateView()
+}
+
+func closePullRequests() {
+	updatePullRequests(func(pr *domain.PullRequest) error {
+		if err := github.ClosePullRequest(pr.ID); err != nil {
+			return err
+		}
+		pr.State = "CLOSED"
+		return nil
+	})
+}
+
+func openPullRequests() {
+	updatePullRequests(func(pr *domain.PullRequest) error {
+		if err := github.ReopenPullRequest(pr.ID); err != nil {
+			return err
+		}
+		pr.State = "OPEN"
+		return nil
+	})
+}
+
+func markPullRequestsReadyForReview() {
+	updatePullRequests(func(pr *domain.PullRequest) error {
+		if !pr.IsDraft {
+			return nil
+		}
+		if err := github.MarkPullRequestReadyForReview(pr.ID); err != nil {
+			return err
+		}
+		pr.IsDraft = false
+		return nil
+	})
+}
+
+func convertPullRequestsToDraft() {
+	updatePullRequests(func(pr *domain.PullRequest) error {
+		if pr.IsDraft {
+			return nil
+		}
+		if err := github.ConvertPullRequestToDraft(pr.ID); err != nil {
+			return err
+		}
+		pr.IsDraft = true
+		return nil
+	})
+}
+
+// changeBaseForm shows a form with a branch picker to change the base branch
+// of the selected pull requests.
+func changeBaseForm() {
+	pullRequests := getSelectedPullRequests()
+	if len(pullRequests) == 0 {
+		return
+	}
+	pr := pullRequests[0]
+
+	closeForm := func() {
+		UI.pages.RemovePage("base").ShowPage("pulls")
+		UI.app.SetFocus(PullRequestUI)
+	}
+
+	form := tview.NewForm()
+	form.SetBorder(true)
+	if len(pullRequests) == 1 {
+		form.SetTitle(fmt.Sprintf("Change base of #%s", pr.Number))
+	} else {
+		form.SetTitle(fmt.Sprintf("Change base of %d pull requests", len(pullRequests)))
+	}
+	form.SetTitleAlign(tview.AlignLeft)
+
+	baseInput := tview.NewInputField().SetLabel("Base").SetText(pr.BaseRef)
+	form.AddFormItem(baseInput)
+	go func() {
+		resp, err := github.GetRepoBranches(map[string]interface{}{
+			"owner":  githubv4.String(pr.RepoOwner),
+			"name":   githubv4.String(pr.Repo),
+			"first":  githubv4.Int(100),
+			"cursor": (*githubv4.String)(nil),
+		})
+		if err != nil {
+			log.Println(err)
+			return
+		}
+
+		var branches []string
+		for _, ref := range resp.Nodes {
+			branches = append(branches, string(ref.Name))
+		}
+		baseInput.SetAutocompleteFunc(func(text string) []string {
+			return branchAutocompleteFunc(text, branches)
+		})
+	}()
+
+	form.AddButton("Change", func() {
+		base := baseInput.GetText()
+		if base == "" {
+			return
+		}
+		closeForm()
+		go updatePullRequests(func(pr *domain.PullRequest) error {
+			if pr.BaseRef == base {
+				return nil
+			}
+			input := githubv4.UpdatePullRequestInput{
+				PullRequestID: githubv4.ID(pr.ID),
+				BaseRefName:   githubv4.NewString(githubv4.String(base)),
+			}
+			if err := github.UpdatePullRequest(input); err != nil {
+				return err
+			}
+			pr.BaseRef = base
+			return nil
+		})
+	})
+	form.AddButton("Cancel", closeForm)
+
+	form.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyCtrlN:
+			k := tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone)
+			UI.app.QueueEvent(k)
+		case tcell.KeyCtrlP:
+			k := tcell.NewEventKey(tcell.KeyBacktab, 0, tcell.ModNone)
+			UI.app.QueueEvent(k)
+		}
+		return event
+	})
+
+	UI.pages.AddAndSwitchToPage("base", UI.Modal(form, 60, 7), true).ShowPage("pulls")
+}
+
+func updateUIRelatedPullRequest(ui *SelectUI, row int) {
+	if row > 0 && row <= len(ui.items) {
+		pr := ui.items[row-1].(*domain.PullRequest)
+		PullRequestViewUI.updateView(pr.Body)
+	}
+}
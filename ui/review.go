@@ -0,0 +1,215 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/shurcooL/githubv4"
+	"github.com/skanehira/ght/domain"
+	"github.com/skanehira/ght/github"
+	"github.com/skanehira/ght/utils"
+)
+
+// pendingReviews holds the not yet submitted review comments per pull request ID.
+var pendingReviews = map[string][]*domain.ReviewComment{}
+
+// addReviewComment opens the editor to write a comment on the diff line under the
+// review cursor and adds it to the pending review of the current pull request.
+// An existing pending comment on the same line is edited instead, and
+// deleted if its body is emptied. Deleting the last pending comment discards
+// the pending review.
+func addReviewComment() error {
+	if currentFile == nil || diffCursor >= len(diffLines) {
+		return domain.ErrNotCommentableLine
+	}
+
+	line := diffLines[diffCursor]
+	comment := &domain.ReviewComment{Path: currentFile.Filename}
+	switch {
+	case line.Kind == domain.DiffLineDeleted:
+		comment.Side = string(githubv4.DiffSideLeft)
+		comment.Line = line.OldLine
+	case line.Kind != domain.DiffLineHunk && line.NewLine > 0:
+		comment.Side = string(githubv4.DiffSideRight)
+		comment.Line = line.NewLine
+	default:
+		return domain.ErrNotCommentableLine
+	}
+
+	prID := currentPullRequest.ID
+	index := -1
+	for i, c := range pendingReviews[prID] {
+		if c.Key() == comment.Key() {
+			comment, index = c, i
+			break
+		}
+	}
+
+	body := comment.Body
+	err := editCommentBody(&body)
+	switch {
+	case errors.Is(err, domain.ErrCommentBodyIsEmpty) && index >= 0:
+		comments := pendingReviews[prID]
+		comments = append(comments[:index:index], comments[index+1:]...)
+		if len(comments) == 0 {
+			delete(pendingReviews, prID)
+		} else {
+			pendingReviews[prID] = comments
+		}
+	case err != nil:
+		return err
+	case index < 0:
+		comment.Body = body
+		pendingReviews[prID] = append(pendingReviews[prID], comment)
+	default:
+		comment.Body = body
+	}
+	updateDiffStatusLine()
+	return nil
+}
+
+// showPendingReview shows the pending review comments of the current pull request in full screen.
+func showPendingReview() {
+	comments := pendingReviews[currentPullRequest.ID]
+	if len(comments) == 0 {
+		UI.Message("No pending review comments", func() {
+			UI.app.SetFocus(DiffViewUI)
+		})
+		return
+	}
+
+	var b strings.Builder
+	for _, c := range comments {
+		fmt.Fprintf(&b, "%s:%d (%s)\n%s\n\n", c.Path, c.Line, c.Side, c.Body)
+	}
+	UI.FullScreenPreview(b.String(), func() {
+		UI.app.SetFocus(DiffViewUI)
+	})
+}
+
+// discardPendingReview drops the pending review comments of the current pull request.
+func discardPendingReview() {
+	pr := currentPullRequest
+	if len(pendingReviews[pr.ID]) == 0 {
+		return
+	}
+
+	msg := fmt.Sprintf("Do you want to discard %d pending review comments?", len(pendingReviews[pr.ID]))
+	UI.Confirm(msg, "Discard", func() error {
+		delete(pendingReviews, pr.ID)
+		updateDiffStatusLine()
+		return nil
+	}, func() {
+		UI.app.SetFocus(DiffViewUI)
+	})
+}
+
+// submitReviewForm shows a form to choose the review verdict and write the
+// review body, then submits the pending review of the current pull request.
+func submitReviewForm() {
+	pr := currentPullRequest
+
+	form := tview.NewForm()
+	form.SetBorder(true)
+	form.SetTitle(fmt.Sprintf("Review #%s (%d pending comments)", pr.Number, len(pendingReviews[pr.ID])))
+	form.SetTitleAlign(tview.AlignLeft)
+
+	events := []githubv4.PullRequestReviewEvent{
+		githubv4.PullRequestReviewEventComment,
+		githubv4.PullRequestReviewEventApprove,
+		githubv4.PullRequestReviewEventRequestChanges,
+	}
+	event := events[0]
+	verdictDropDown := tview.NewDropDown().SetLabel("Verdict").
+		SetOptions([]string{"Comment", "Approve", "Request changes"}, func(text string, index int) {
+			event = events[index]
+		}).
+		SetCurrentOption(0)
+	form.AddFormItem(verdictDropDown)
+
+	closeForm := func() {
+		UI.pages.RemovePage("review").ShowPage("diff")
+		UI.app.SetFocus(DiffViewUI)
+	}
+
+	var body string
+	form.AddButton("Edit Body", func() {
+		UI.app.Suspend(func() {
+			if err := utils.Edit(&body); err != nil {
+				log.Println(err)
+				return
+			}
+		})
+	})
+	form.AddButton("Submit", func() {
+		if err := submitReview(pr, event, body); err != nil {
+			UI.Message(err.Error(), func() {
+				UI.pages.SwitchToPage("review").ShowPage("diff")
+			})
+			return
+		}
+		closeForm()
+		updateDiffStatusLine()
+		UI.Message("Review submitted", func() {
+			UI.app.SetFocus(DiffViewUI)
+		})
+	})
+	form.AddButton("Cancel", closeForm)
+
+	form.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyCtrlN:
+			k := tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone)
+			UI.app.QueueEvent(k)
+		case tcell.KeyCtrlP:
+			k := tcell.NewEventKey(tcell.KeyBacktab, 0, tcell.ModNone)
+			UI.app.QueueEvent(k)
+		}
+		return event
+	})
+
+	UI.pages.AddAndSwitchToPage("review", UI.Modal(form, 60, 7), true).ShowPage("diff")
+}
+
+// submitReview submits the pending review comments of the pull request with
+// the given verdict in a single addPullRequestReview mutation.
+func submitReview(pr *domain.PullRequest, event githubv4.PullRequestReviewEvent, body string) error {
+	comments := pendingReviews[pr.ID]
+
+	if body == "" && len(comments) == 0 && event != githubv4.PullRequestReviewEventApprove {
+		return domain.ErrReviewBodyIsEmpty
+	}
+
+	input := githubv4.AddPullRequestReviewInput{
+		PullRequestID: githubv4.ID(pr.ID),
+		Event:         &event,
+	}
+	if body != "" {
+		input.Body = githubv4.NewString(githubv4.String(body))
+	}
+
+	if len(comments) > 0 {
+		threads := make([]*githubv4.DraftPullRequestReviewThread, len(comments))
+		for i, c := range comments {
+			side := githubv4.DiffSide(c.Side)
+			threads[i] = &githubv4.DraftPullRequestReviewThread{
+				Path: githubv4.String(c.Path),
+				Line: githubv4.Int(c.Line),
+				Side: &side,
+				Body: githubv4.String(c.Body),
+			}
+		}
+		input.Threads = &threads
+	}
+
+	if err := github.AddPullRequestReview(input); err != nil {
+		return err
+	}
+
+	delete(pendingReviews, pr.ID)
+	return nil
+}
@@ -17,15 +17,34 @@ const (
 type UIKind string
 
 const (
-	UIKindIssue       UIKind = "issues"
-	UIKindAssignee           = "assignees"
-	UIKindComment            = "comments"
-	UIKindLabel              = "labels"
-	UIKindMilestones         = "milestones"
-	UIKindProject            = "projects"
-	UIKindIssueView          = "issue preview"
-	UIKindCommentView        = "comment preview"
-	UIKindCommonView         = "preview"
+	UIKindIssue             UIKind = "issues"
+	UIKindAssignee                 = "assignees"
+	UIKindComment                  = "comments"
+	UIKindLabel                    = "labels"
+	UIKindMilestones               = "milestones"
+	UIKindProject                  = "projects"
+	UIKindPullRequest              = "pull requests"
+	UIKindPullRequestFile          = "files"
+	UIKindStatusCheck              = "checks"
+	UIKindWorkflowStep             = "steps"
+	UIKindArtifact                 = "artifacts"
+	UIKindArtifactFile             = "artifact files"
+	UIKindPendingDeployment        = "pending deployments"
+	UIKindRunAttempt               = "attempts"
+	UIKindAttemptComparison        = "attempt comparison"
+	UIKindActionsSetting           = "secrets and variables"
+	UIKindTimeline                 = "timeline"
+	UIKindIssueView                = "issue preview"
+	UIKindCommentView              = "comment preview"
+	UIKindPullRequestView          = "pull request preview"
+	UIKindDiffView                 = "diff"
+	UIKindStepLogView              = "step log"
+	UIKindArtifactView             = "artifact preview"
+	UIKindActionsStatsView         = "statistics"
+	UIKindWorkflowFileView         = "workflow file"
+	UIKindWorkflowOutline          = "outline"
+	UIKindTimelineView             = "timeline preview"
+	UIKindCommonView               = "preview"
 )
 
 type (
@@ -92,6 +111,9 @@ func (ui *SelectUI) SetList(list []domain.Item) {
 func (ui *SelectUI) FetchList() {
 	if ui.hasNext && ui.getList != nil {
 		list, pageInfo := ui.getList(ui.cursor)
+		if pageInfo == nil {
+			return
+		}
 		ui.hasNext = bool(pageInfo.HasNextPage)
 		cursor := string(pageInfo.EndCursor)
 		ui.originItems = append(ui.originItems, list...)
@@ -115,6 +137,7 @@ func (ui *SelectUI) UpdateView() {
 		}
 
 		if len(ui.originItems) < 1 {
+			ui.items = nil
 			return
 		}
 
@@ -153,12 +176,43 @@ func (ui *SelectUI) UpdateView() {
 		ui.ScrollToBeginning()
 
 		// when update filter, then update ui related issue primitives
-		if ui.uiKind == UIKindIssue {
+		switch ui.uiKind {
+		case UIKindIssue:
 			row, _ := ui.GetSelection()
 			if row == 0 {
 				row = 1
 			}
 			updateUIRelatedIssue(ui, row)
+		case UIKindPullRequest:
+			row, _ := ui.GetSelection()
+			if row == 0 {
+				row = 1
+			}
+			updateUIRelatedPullRequest(ui, row)
+		case UIKindPullRequestFile:
+			row, _ := ui.GetSelection()
+			if row == 0 {
+				row = 1
+			}
+			updateDiffView(ui, row)
+		case UIKindWorkflowStep:
+			row, _ := ui.GetSelection()
+			if row == 0 {
+				row = 1
+			}
+			updateStepLogView(ui, row)
+		case UIKindArtifactFile:
+			row, _ := ui.GetSelection()
+			if row == 0 {
+				row = 1
+			}
+			updateArtifactView(ui, row)
+		case UIKindTimeline:
+			row, _ := ui.GetSelection()
+			if row == 0 {
+				row = 1
+			}
+			updateTimelineView(ui, row)
 		}
 	}
 }
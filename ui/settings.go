@@ -0,0 +1,268 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/skanehira/ght/config"
+	"github.com/skanehira/ght/domain"
+	"github.com/skanehira/ght/github"
+)
+
+var (
+	ActionsSettingsUI *SelectUI
+
+	// settingsOpened reports whether the settings view was opened, so they
+	// are not fetched before they are needed.
+	settingsOpened bool
+
+	// settingsEnvironments are the environments of the repository, whose
+	// secrets and variables are listed besides the repository's own.
+	settingsEnvironments []string
+)
+
+// newActionsSettingsUI creates the view with the Actions secrets and
+// variables of the repository and of its environments.
+func newActionsSettingsUI() tview.Primitive {
+	opt := func(ui *SelectUI) {
+		ui.header = []string{
+			"",
+			"Kind",
+			"Scope",
+			"Name",
+			"Value",
+			"Updated",
+		}
+		ui.hasHeader = true
+
+		ui.getList = func(cursor *string) ([]domain.Item, *github.PageInfo) {
+			if !settingsOpened {
+				return nil, nil
+			}
+
+			ctx := context.Background()
+			owner := config.GitHub.Owner
+			repo := config.GitHub.Repo
+
+			// a token without access to environments still sees the
+			// settings of the repository
+			environments, err := github.ListEnvironments(ctx, owner, repo)
+			if err != nil {
+				log.Println(err)
+			}
+			var names []string
+			for _, env := range environments {
+				names = append(names, env.GetName())
+			}
+			settingsEnvironments = names
+
+			settings, err := github.ListActionsSettings(ctx, owner, repo, names)
+			if err != nil {
+				log.Println(err)
+				return nil, nil
+			}
+
+			items := make([]domain.Item, len(settings))
+			for i, setting := range settings {
+				items[i] = setting
+			}
+
+			// All settings are fetched at once
+			pageInfo := &github.PageInfo{HasNextPage: false}
+			return items, pageInfo
+		}
+
+		ui.capture = func(event *tcell.EventKey) *tcell.EventKey {
+			switch event.Key() {
+			case tcell.KeyEscape:
+				ActionsSettingsUI.blur()
+				switchToRunsView()
+				return nil
+			}
+
+			switch event.Rune() {
+			case 'r':
+				go ActionsSettingsUI.GetList()
+			case 'n':
+				actionsSettingForm(nil)
+			case 'e':
+				if item := ActionsSettingsUI.GetSelect(); item != nil {
+					actionsSettingForm(item.(*domain.ActionsSetting))
+				}
+			case 'd':
+				if item := ActionsSettingsUI.GetSelect(); item != nil {
+					deleteActionsSetting(item.(*domain.ActionsSetting))
+				}
+			}
+			return event
+		}
+	}
+
+	ActionsSettingsUI = NewSelectListUI(UIKindActionsSetting, tcell.ColorRed, opt)
+	return ActionsSettingsUI
+}
+
+// openActionsSettings switches to the secrets and variables of the repository.
+func openActionsSettings() {
+	settingsOpened = true
+	ActionsSettingsUI.SetList(nil)
+
+	actionsPages.SwitchToPage("settings-view")
+	WorkflowRunsUI.blur()
+	ActionsSettingsUI.focus()
+	UI.app.SetFocus(ActionsSettingsUI)
+	updateActionsStatusLine()
+	go ActionsSettingsUI.GetList()
+}
+
+// actionsSettingForm shows a form to create a secret or variable, or to
+// update the value of setting if it is not nil. The value of a secret is
+// encrypted before it leaves the machine.
+func actionsSettingForm(setting *domain.ActionsSetting) {
+	focus := func() {
+		UI.app.SetFocus(ActionsSettingsUI)
+	}
+
+	form := tview.NewForm()
+	form.SetBorder(true)
+	form.SetTitleAlign(tview.AlignLeft)
+	inputWidth := 10
+
+	secret := setting != nil && setting.Secret
+	env := ""
+	nameInput := tview.NewInputField().SetLabel("Name").SetLabelWidth(inputWidth)
+	valueInput := tview.NewInputField().SetLabel("Value").SetLabelWidth(inputWidth)
+
+	if setting == nil {
+		form.SetTitle("New secret or variable")
+
+		kindDropDown := tview.NewDropDown().SetLabel("Kind").SetLabelWidth(inputWidth).
+			SetOptions([]string{"variable", "secret"}, func(text string, index int) {
+				secret = index == 1
+				if secret {
+					valueInput.SetMaskCharacter('*')
+				} else {
+					valueInput.SetMaskCharacter(0)
+				}
+			}).
+			SetCurrentOption(0)
+		form.AddFormItem(kindDropDown)
+
+		scopes := append([]string{"repository"}, settingsEnvironments...)
+		scopeDropDown := tview.NewDropDown().SetLabel("Scope").SetLabelWidth(inputWidth).
+			SetOptions(scopes, func(text string, index int) {
+				env = ""
+				if index > 0 {
+					env = text
+				}
+			}).
+			SetCurrentOption(0)
+		form.AddFormItem(scopeDropDown)
+		form.AddFormItem(nameInput)
+	} else {
+		form.SetTitle(fmt.Sprintf("Update %s %s of %s", setting.Kind(), setting.Name, setting.Scope()))
+		env = setting.Environment
+		nameInput.SetText(setting.Name)
+		if secret {
+			valueInput.SetMaskCharacter('*')
+		} else {
+			valueInput.SetText(setting.Value)
+		}
+	}
+	form.AddFormItem(valueInput)
+
+	closeForm := func() {
+		UI.pages.RemovePage("actions-setting").ShowPage("actions")
+		focus()
+	}
+
+	form.AddButton("Save", func() {
+		name := nameInput.GetText()
+		value := valueInput.GetText()
+		backToForm := func() {
+			UI.pages.SwitchToPage("actions-setting").ShowPage("actions")
+		}
+		if err := github.ValidateSettingName(name); err != nil {
+			UI.Message(err.Error(), backToForm)
+			return
+		}
+		// the value of a secret is not shown, so an empty one is not a
+		// value left as it was
+		if secret && value == "" {
+			UI.Message("the value of a secret cannot be empty", backToForm)
+			return
+		}
+		closeForm()
+
+		go func() {
+			ctx := context.Background()
+			owner := config.GitHub.Owner
+			repo := config.GitHub.Repo
+
+			var err error
+			if secret {
+				err = github.SetSecret(ctx, owner, repo, env, name, value)
+			} else {
+				err = github.SetVariable(ctx, owner, repo, env, name, value, setting == nil)
+			}
+			if err != nil {
+				UI.updater <- func() {
+					UI.Message(err.Error(), focus)
+				}
+				return
+			}
+			ActionsSettingsUI.GetList()
+		}()
+	})
+	form.AddButton("Cancel", closeForm)
+
+	form.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyCtrlN:
+			k := tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone)
+			UI.app.QueueEvent(k)
+		case tcell.KeyCtrlP:
+			k := tcell.NewEventKey(tcell.KeyBacktab, 0, tcell.ModNone)
+			UI.app.QueueEvent(k)
+		case tcell.KeyEscape:
+			closeForm()
+			return nil
+		}
+		return event
+	})
+
+	height := 7
+	if setting == nil {
+		height = 13
+	}
+	UI.pages.AddAndSwitchToPage("actions-setting", UI.Modal(form, 80, height), true).ShowPage("actions")
+}
+
+// deleteActionsSetting deletes a secret or variable after confirmation.
+func deleteActionsSetting(setting *domain.ActionsSetting) {
+	focus := func() {
+		UI.app.SetFocus(ActionsSettingsUI)
+	}
+
+	msg := fmt.Sprintf("Delete %s %s of %s?", setting.Kind(), setting.Name, setting.Scope())
+	UI.Confirm(msg, "Delete", func() error {
+		ctx := context.Background()
+		owner := config.GitHub.Owner
+		repo := config.GitHub.Repo
+
+		var err error
+		if setting.Secret {
+			err = github.DeleteSecret(ctx, owner, repo, setting.Environment, setting.Name)
+		} else {
+			err = github.DeleteVariable(ctx, owner, repo, setting.Environment, setting.Name)
+		}
+		if err != nil {
+			return err
+		}
+		go ActionsSettingsUI.GetList()
+		return nil
+	}, focus)
+}
@@ -0,0 +1,245 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"sync"
+
+	"github.com/gdamore/tcell/v2"
+	gogithub "github.com/google/go-github/v68/github"
+	"github.com/rivo/tview"
+	"github.com/skanehira/ght/config"
+	"github.com/skanehira/ght/github"
+)
+
+const (
+	// statsRunLimit is how many of the newest runs the statistics are computed from.
+	statsRunLimit = 300
+
+	// statsJobRuns is how many of those runs the jobs are fetched of to find
+	// the slowest jobs, and statsJobLimit how many slowest jobs are shown.
+	statsJobRuns  = 20
+	statsJobLimit = 10
+
+	// statsSparkLength is how many of the newest run durations a sparkline shows.
+	statsSparkLength = 30
+)
+
+// sparkTicks are the bars of a sparkline, from lowest to highest.
+var sparkTicks = []rune("▁▂▃▄▅▆▇█")
+
+// newActionsStatsUI creates the statistics view of the runs matching the runs filter.
+func newActionsStatsUI() tview.Primitive {
+	ActionsStatsViewUI.capture = func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEscape:
+			switchToRunsView()
+			return nil
+		}
+		switch event.Rune() {
+		case 'r':
+			loadActionsStats()
+		}
+		return event
+	}
+	return ActionsStatsViewUI
+}
+
+// openActionsStats switches to the statistics view and computes the statistics.
+func openActionsStats() {
+	actionsPages.SwitchToPage("stats-view")
+	WorkflowRunsUI.blur()
+	UI.app.SetFocus(ActionsStatsViewUI)
+	updateActionsStatusLine()
+	loadActionsStats()
+}
+
+// loadActionsStats fetches the newest runs matching the runs filter, and the
+// jobs of the newest of them, and renders their statistics.
+func loadActionsStats() {
+	opts, err := github.ParseRunFilter(RunFilterUI.GetQuery())
+	if err != nil {
+		UI.Message(err.Error(), func() {
+			UI.app.SetFocus(ActionsStatsViewUI)
+		})
+		return
+	}
+	if opts.Status == "" && actionsStatusFilter != "" {
+		opts.Status = actionsStatusFilter
+	}
+
+	ActionsStatsViewUI.SetText(fmt.Sprintf("Loading up to %d runs...", statsRunLimit))
+
+	go func() {
+		ctx := context.Background()
+		owner := config.GitHub.Owner
+		repo := config.GitHub.Repo
+
+		runs, err := github.ListRecentWorkflowRuns(ctx, owner, repo, actionsWorkflowID, opts, statsRunLimit)
+		if err != nil {
+			UI.updater <- func() {
+				ActionsStatsViewUI.SetText(tview.Escape(err.Error()))
+			}
+			return
+		}
+
+		jobs, jobRuns := listRecentJobs(ctx, runs)
+
+		text := renderActionsStats(runs, jobs, jobRuns)
+		UI.updater <- func() {
+			ActionsStatsViewUI.SetText(text).ScrollToBeginning()
+		}
+	}()
+}
+
+// listRecentJobs fetches the jobs of the newest completed runs of runs and
+// returns them with the number of runs they are of.
+func listRecentJobs(ctx context.Context, runs []*gogithub.WorkflowRun) ([]*gogithub.WorkflowJob, int) {
+	var completed []*gogithub.WorkflowRun
+	for _, run := range runs {
+		if run.GetStatus() == "completed" {
+			completed = append(completed, run)
+		}
+		if len(completed) == statsJobRuns {
+			break
+		}
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	var jobs []*gogithub.WorkflowJob
+	for _, run := range completed {
+		wg.Add(1)
+		go func(run *gogithub.WorkflowRun) {
+			defer wg.Done()
+			attempt := run.GetRunAttempt()
+			if attempt < 1 {
+				attempt = 1
+			}
+			list, err := github.ListWorkflowJobsAttempt(ctx, config.GitHub.Owner, config.GitHub.Repo, run.GetID(), attempt)
+			if err != nil {
+				log.Println(err)
+				return
+			}
+			mu.Lock()
+			jobs = append(jobs, list...)
+			mu.Unlock()
+		}(run)
+	}
+	wg.Wait()
+	return jobs, len(completed)
+}
+
+// renderActionsStats renders the statistics of runs and jobs, which are of
+// the jobRuns newest completed runs, as tables.
+func renderActionsStats(runs []*gogithub.WorkflowRun, jobs []*gogithub.WorkflowJob, jobRuns int) string {
+	var b strings.Builder
+
+	workflow := "all"
+	if actionsWorkflowName != "" {
+		workflow = actionsWorkflowName
+	}
+	filter := RunFilterUI.GetQuery()
+	if filter == "" {
+		filter = "none"
+	}
+	fmt.Fprintf(&b, "%d newest runs | Workflow: %s | Filter: %s\n\n", len(runs), tview.Escape(workflow), tview.Escape(filter))
+
+	// workflows
+	b.WriteString("[yellow::b]Workflows[-:-:-]\n")
+	stats := github.ComputeWorkflowStats(runs)
+	rows := [][]string{{"Workflow", "Runs", "Success rate", "Median", "p95", "Durations"}}
+	for _, s := range stats {
+		durations := s.Durations
+		if len(durations) > statsSparkLength {
+			durations = durations[len(durations)-statsSparkLength:]
+		}
+		rate := "-"
+		if s.Successes+s.Failures > 0 {
+			rate = fmt.Sprintf("%s %3.0f%%", rateBar(s.SuccessRate, 10), s.SuccessRate*100)
+		}
+		rows = append(rows, []string{s.Workflow, fmt.Sprint(s.Runs), rate, s.Median, s.P95, sparkline(durations)})
+	}
+	writeStatsTable(&b, rows)
+
+	// failure streaks
+	b.WriteString("\n[yellow::b]Failure streaks[-:-:-]\n")
+	streaks := github.ComputeFailureStreaks(runs)
+	if len(streaks) == 0 {
+		b.WriteString("No workflow is failing on any branch.\n")
+	} else {
+		rows = [][]string{{"Workflow", "Branch", "Failures", "Since"}}
+		for _, s := range streaks {
+			rows = append(rows, []string{s.Workflow, s.Branch, fmt.Sprint(s.Failures), s.Since})
+		}
+		writeStatsTable(&b, rows)
+	}
+
+	// slowest jobs
+	fmt.Fprintf(&b, "\n[yellow::b]Slowest jobs[-:-:-] (of the %d newest completed runs)\n", jobRuns)
+	rows = [][]string{{"Workflow", "Job", "Runs", "Median", "Max"}}
+	for _, s := range github.ComputeSlowestJobs(jobs, statsJobLimit) {
+		rows = append(rows, []string{s.Workflow, s.Name, fmt.Sprint(s.Runs), s.Median, s.Max})
+	}
+	writeStatsTable(&b, rows)
+
+	return b.String()
+}
+
+// writeStatsTable writes rows as a table with aligned columns and the first row as header.
+func writeStatsTable(b *strings.Builder, rows [][]string) {
+	widths := make([]int, len(rows[0]))
+	for _, row := range rows {
+		for i, cell := range row {
+			if n := len([]rune(cell)); n > widths[i] {
+				widths[i] = n
+			}
+		}
+	}
+
+	for r, row := range rows {
+		if r == 0 {
+			b.WriteString("[::u]")
+		}
+		for i, cell := range row {
+			pad := widths[i] - len([]rune(cell))
+			b.WriteString(tview.Escape(cell) + strings.Repeat(" ", pad+2))
+		}
+		if r == 0 {
+			b.WriteString("[::-]")
+		}
+		b.WriteString("\n")
+	}
+}
+
+// sparkline draws values as a line of bars scaled between their minimum and maximum.
+func sparkline(values []float64) string {
+	if len(values) == 0 {
+		return ""
+	}
+
+	min, max := values[0], values[0]
+	for _, v := range values {
+		min = math.Min(min, v)
+		max = math.Max(max, v)
+	}
+
+	var b strings.Builder
+	for _, v := range values {
+		i := 0
+		if max > min {
+			i = int((v - min) / (max - min) * float64(len(sparkTicks)-1))
+		}
+		b.WriteRune(sparkTicks[i])
+	}
+	return b.String()
+}
+
+// rateBar draws a rate between 0 and 1 as a bar of width cells.
+func rateBar(rate float64, width int) string {
+	filled := int(math.Round(rate * float64(width)))
+	return strings.Repeat("█", filled) + strings.Repeat("░", width-filled)
+}
@@ -0,0 +1,29 @@
+package ui
+
+import "testing"
+
+func TestSparkline(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []float64
+		want   string
+	}{
+		{name: "empty", values: nil, want: ""},
+		{name: "flat", values: []float64{3, 3}, want: "▁▁"},
+		{name: "rising", values: []float64{0, 7, 14}, want: "▁▄█"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sparkline(tt.values); got != tt.want {
+				t.Errorf("sparkline(%v) = %q, want %q", tt.values, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRateBar(t *testing.T) {
+	if got, want := rateBar(0.75, 4), "███░"; got != want {
+		t.Errorf("rateBar(0.75, 4) = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,320 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/skanehira/ght/config"
+	"github.com/skanehira/ght/domain"
+	"github.com/skanehira/ght/github"
+)
+
+var (
+	WorkflowStepsUI *SelectUI
+
+	currentJob *domain.WorkflowJob
+
+	// stepLogStore is the log of currentJob and stepStarts the lines at which
+	// the logs of its steps start. stepSections are the sections of the log
+	// shown in StepLogViewUI and stepCursor the index of the section the group
+	// cursor is on.
+	stepLogStore *github.LogStore
+	stepStarts   []int
+	stepSections []*domain.LogSection
+	stepCursor   int
+)
+
+// newWorkflowStepsUI creates the steps view with the steps of a job and the log of the selected step.
+func newWorkflowStepsUI() tview.Primitive {
+	opt := func(ui *SelectUI) {
+		ui.header = []string{
+			"",
+			"Status",
+			"Step",
+			"Duration",
+		}
+		ui.hasHeader = true
+
+		ui.getList = func(cursor *string) ([]domain.Item, *github.PageInfo) {
+			if currentJob == nil {
+				return nil, nil
+			}
+
+			ctx := context.Background()
+			owner := config.GitHub.Owner
+			repo := config.GitHub.Repo
+
+			j, err := github.GetWorkflowJob(ctx, owner, repo, currentJob.ID)
+			if err != nil {
+				log.Println(err)
+				return nil, nil
+			}
+			job := github.ConvertWorkflowJob(j)
+			currentJob = job
+
+			if job.Status == "completed" {
+				go openStepLogStore(job)
+			}
+
+			items := make([]domain.Item, len(job.Steps))
+			for i, step := range job.Steps {
+				items[i] = step
+			}
+
+			// Steps are not paginated (all returned with the job)
+			pageInfo := &github.PageInfo{HasNextPage: false}
+			return items, pageInfo
+		}
+
+		ui.capture = func(event *tcell.EventKey) *tcell.EventKey {
+			switch event.Key() {
+			case tcell.KeyEscape:
+				closeWorkflowSteps()
+				return nil
+			case tcell.KeyEnter:
+				WorkflowStepsUI.blur()
+				UI.app.SetFocus(StepLogViewUI)
+				return nil
+			}
+
+			switch event.Rune() {
+			case 'r':
+				go WorkflowStepsUI.GetList()
+			}
+			return event
+		}
+	}
+
+	WorkflowStepsUI = NewSelectListUI(UIKindWorkflowStep, tcell.ColorYellow, opt)
+
+	WorkflowStepsUI.SetSelectionChangedFunc(func(row, col int) {
+		updateStepLogView(WorkflowStepsUI, row)
+	})
+
+	StepLogViewUI.capture = func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEscape:
+			WorkflowStepsUI.focus()
+			UI.app.SetFocus(WorkflowStepsUI)
+			return nil
+		case tcell.KeyEnter:
+			toggleStepSection()
+			return nil
+		}
+
+		switch event.Rune() {
+		case 'J':
+			moveStepCursor(1)
+		case 'K':
+			moveStepCursor(-1)
+		case 'E':
+			setStepSectionsCollapsed(false)
+		case 'C':
+			setStepSectionsCollapsed(true)
+		}
+		return event
+	}
+
+	grid := tview.NewGrid().SetColumns(-1, -2).
+		AddItem(WorkflowStepsUI, 0, 0, 1, 1, 0, 0, true).
+		AddItem(StepLogViewUI, 0, 1, 1, 1, 0, 0, true)
+
+	return grid
+}
+
+// openWorkflowSteps switches to the steps view of a job.
+func openWorkflowSteps(job *domain.WorkflowJob) {
+	currentJob = job
+	closeStepLogStore()
+	stepSections = nil
+
+	WorkflowStepsUI.SetList(nil)
+	StepLogViewUI.Clear()
+	StepLogViewUI.SetTitle(string(UIKindStepLogView))
+
+	actionsPages.SwitchToPage("steps-view")
+	WorkflowJobsUI.blur()
+	WorkflowStepsUI.focus()
+	UI.app.SetFocus(WorkflowStepsUI)
+	updateActionsStatusLine()
+	go WorkflowStepsUI.GetList()
+}
+
+// openStepLogStore downloads the full log of a completed job to the cache and
+// shows the log of the selected step from it.
+func openStepLogStore(job *domain.WorkflowJob) {
+	ctx, cancel := context.WithTimeout(context.Background(), logDownloadTimeout)
+	defer cancel()
+
+	store, err := github.OpenJobLog(ctx, config.GitHub.Owner, config.GitHub.Repo, job.ID)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	starts := store.StepStarts(job.Steps)
+
+	UI.app.QueueUpdateDraw(func() {
+		// the steps view may have been left or opened for another job
+		name, _ := actionsPages.GetFrontPage()
+		if name != "steps-view" || currentJob == nil || currentJob.ID != job.ID {
+			store.Close()
+			return
+		}
+		closeStepLogStore()
+		stepLogStore = store
+		stepStarts = starts
+
+		row, _ := WorkflowStepsUI.GetSelection()
+		updateStepLogView(WorkflowStepsUI, row)
+	})
+}
+
+// closeStepLogStore closes the log of the steps view, if any.
+func closeStepLogStore() {
+	if stepLogStore == nil {
+		return
+	}
+	if err := stepLogStore.Close(); err != nil {
+		log.Println(err)
+	}
+	stepLogStore = nil
+	stepStarts = nil
+}
+
+// closeWorkflowSteps returns from the steps view to the jobs view.
+func closeWorkflowSteps() {
+	closeStepLogStore()
+	actionsPages.SwitchToPage("jobs-view")
+	WorkflowStepsUI.blur()
+	WorkflowJobsUI.focus()
+	UI.app.SetFocus(WorkflowJobsUI)
+	updateActionsStatusLine()
+}
+
+func updateStepLogView(ui *SelectUI, row int) {
+	if row < 1 || row > len(ui.items) {
+		return
+	}
+	step := ui.items[row-1].(*domain.WorkflowStep)
+
+	StepLogViewUI.SetTitle(fmt.Sprintf("%s (%s)", step.Name, step.Duration))
+	store, starts := stepLogStore, stepStarts
+	if store == nil {
+		stepSections = nil
+		StepLogViewUI.SetText("Log not available. The job may still be running or logs may have expired.")
+		return
+	}
+
+	// items can be filtered, so find the step by its position in the job
+	index := -1
+	for i, s := range currentJob.Steps {
+		if s.Number == step.Number {
+			index = i
+			break
+		}
+	}
+
+	// a step can have a huge log, so it is read off the UI thread
+	go func() {
+		var lines []string
+		if index >= 0 && index+1 < len(starts) {
+			l, err := store.Lines(starts[index], starts[index+1]-starts[index])
+			if err != nil {
+				log.Println(err)
+			}
+			lines = l
+		}
+		sections := github.ParseLogSections(strings.Join(lines, "\n"))
+
+		UI.updater <- func() {
+			// the cursor may have moved to another step in the meantime
+			if stepLogStore != store {
+				return
+			}
+			if item := WorkflowStepsUI.GetSelect(); item == nil || item.Key() != step.Key() {
+				return
+			}
+			stepSections = sections
+			stepCursor = 0
+			for i, section := range stepSections {
+				// start on the first group so Enter unfolds it
+				if section.IsGroup {
+					stepCursor = i
+					break
+				}
+			}
+			renderStepLog()
+			StepLogViewUI.ScrollToBeginning()
+		}
+	}()
+}
+
+// renderStepLog draws the sections of the step log, folding collapsed groups
+// to their title, and highlights the section under the cursor.
+func renderStepLog() {
+	var b strings.Builder
+	for i, section := range stepSections {
+		if section.IsGroup {
+			marker := "▼"
+			if section.Collapsed {
+				marker = "▶"
+			}
+			fmt.Fprintf(&b, `["%s"][yellow]%s %s (%d lines)[white][""]`+"\n",
+				stepSectionRegion(i), marker, tview.Escape(section.Title), len(section.Lines))
+			if section.Collapsed {
+				continue
+			}
+			for _, line := range section.Lines {
+				b.WriteString("  " + tview.Escape(line) + "\n")
+			}
+			continue
+		}
+
+		fmt.Fprintf(&b, `["%s"]`, stepSectionRegion(i))
+		for _, line := range section.Lines {
+			b.WriteString(tview.Escape(line) + "\n")
+		}
+		b.WriteString(`[""]`)
+	}
+
+	StepLogViewUI.SetText(b.String())
+	StepLogViewUI.Highlight(stepSectionRegion(stepCursor))
+}
+
+func stepSectionRegion(index int) string {
+	return fmt.Sprintf("s%d", index)
+}
+
+// moveStepCursor moves the group cursor to the next (delta 1) or previous (delta -1) group.
+func moveStepCursor(delta int) {
+	for i := stepCursor + delta; i >= 0 && i < len(stepSections); i += delta {
+		if stepSections[i].IsGroup {
+			stepCursor = i
+			StepLogViewUI.Highlight(stepSectionRegion(stepCursor)).ScrollToHighlight()
+			return
+		}
+	}
+}
+
+// toggleStepSection folds or unfolds the group under the cursor.
+func toggleStepSection() {
+	if stepCursor >= len(stepSections) || !stepSections[stepCursor].IsGroup {
+		return
+	}
+	section := stepSections[stepCursor]
+	section.Collapsed = !section.Collapsed
+	renderStepLog()
+	StepLogViewUI.ScrollToHighlight()
+}
+
+func setStepSectionsCollapsed(collapsed bool) {
+	for _, section := range stepSections {
+		section.Collapsed = collapsed
+	}
+	renderStepLog()
+	StepLogViewUI.ScrollToHighlight()
+}
@@ -0,0 +1,180 @@
+package ui
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/shurcooL/githubv4"
+	"github.com/skanehira/ght/domain"
+	"github.com/skanehira/ght/github"
+	"github.com/skanehira/ght/utils"
+)
+
+var (
+	TimelineUI *SelectUI
+
+	timelineStatusLine *tview.TextView
+	timelineFocus      *focusRing
+
+	timelineIssue *domain.Issue
+)
+
+// NewTimelineUI creates the timeline page with the comments and events of an issue.
+func NewTimelineUI() tview.Primitive {
+	opt := func(ui *SelectUI) {
+		ui.header = []string{
+			"",
+			"CreatedAt",
+			"Actor",
+			"Event",
+			"Summary",
+		}
+		ui.hasHeader = true
+
+		ui.getList = func(cursor *string) ([]domain.Item, *github.PageInfo) {
+			issue := timelineIssue
+			if issue == nil {
+				return nil, nil
+			}
+
+			number, err := strconv.Atoi(issue.Number)
+			if err != nil {
+				log.Println(err)
+				return nil, nil
+			}
+
+			resp, err := github.GetIssueTimeline(map[string]interface{}{
+				"owner":  githubv4.String(issue.RepoOwner),
+				"name":   githubv4.String(issue.Repo),
+				"number": githubv4.Int(number),
+				"first":  githubv4.Int(100),
+				"cursor": (*githubv4.String)(cursor),
+			})
+			if err != nil {
+				log.Println(err)
+				return nil, nil
+			}
+			// another issue may have been opened while loading
+			if issue != timelineIssue {
+				return nil, nil
+			}
+
+			// the timeline is already in chronological order
+			var items []domain.Item
+			for _, node := range resp.Nodes {
+				if event := node.ToDomain(); event != nil {
+					items = append(items, event)
+				}
+			}
+
+			UI.updater <- func() {
+				if issue == timelineIssue {
+					TimelineUI.SetTitle(fmt.Sprintf("%s (%d)", UIKindTimeline, resp.TotalCount))
+				}
+			}
+			return items, &resp.PageInfo
+		}
+
+		ui.capture = func(event *tcell.EventKey) *tcell.EventKey {
+			switch event.Key() {
+			case tcell.KeyEscape:
+				closeTimeline()
+				return nil
+			case tcell.KeyCtrlO:
+				if item := TimelineUI.GetSelect(); item != nil {
+					openTimelineEvent(item.(*domain.TimelineEvent))
+				}
+			}
+			switch event.Rune() {
+			case 'r':
+				go TimelineUI.GetList()
+			}
+			return event
+		}
+	}
+
+	TimelineUI = NewSelectListUI(UIKindTimeline, tcell.ColorLightSkyBlue, opt)
+
+	TimelineUI.SetSelectionChangedFunc(func(row, col int) {
+		updateTimelineView(TimelineUI, row)
+	})
+
+	TimelineViewUI.capture = func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEscape:
+			timelineFocus.focusAt(0)
+			return nil
+		}
+		return event
+	}
+
+	timelineStatusLine = tview.NewTextView().SetDynamicColors(true)
+
+	timelineFocus = &focusRing{
+		primitives: []Primitive{TimelineUI, TimelineViewUI},
+	}
+
+	grid := tview.NewGrid().SetRows(1, 0, 0).
+		AddItem(timelineStatusLine, 0, 0, 1, 1, 0, 0, false).
+		AddItem(TimelineUI, 1, 0, 1, 1, 0, 0, true).
+		AddItem(TimelineViewUI, 2, 0, 1, 1, 0, 0, true)
+
+	return grid
+}
+
+// openTimeline switches to the timeline page and loads the timeline of the issue.
+func openTimeline(issue *domain.Issue) {
+	timelineIssue = issue
+
+	TimelineUI.SetList(nil)
+	TimelineUI.SetTitle(string(UIKindTimeline))
+	TimelineViewUI.Clear()
+	timelineStatusLine.SetText(fmt.Sprintf(
+		"Issue #%s %s | Ctrl+O: browser | Esc: back | [f]etch more | [r]efresh",
+		issue.Number, tview.Escape(issue.Title),
+	))
+
+	timelineFocus.current = 0
+	UI.switchToPage("timeline")
+	go TimelineUI.GetList()
+}
+
+func closeTimeline() {
+	UI.switchToPage("main")
+	p := UI.primitives[UI.current]
+	p.focus()
+	UI.app.SetFocus(p)
+}
+
+func openTimelineEvent(event *domain.TimelineEvent) {
+	url := event.URL
+	if url == "" {
+		url = timelineIssue.URL
+	}
+	if err := utils.Open(url); err != nil {
+		log.Println(err)
+	}
+}
+
+func updateTimelineView(ui *SelectUI, row int) {
+	if row < 1 || row > len(ui.items) {
+		return
+	}
+	event := ui.items[row-1].(*domain.TimelineEvent)
+
+	var lines []string
+	if event.Event != "commented" {
+		lines = append(lines, event.Summary, "")
+	}
+	if event.Body != "" {
+		lines = append(lines, event.Body, "")
+	}
+	if event.URL != "" {
+		lines = append(lines, event.URL)
+	}
+	TimelineViewUI.updateView(strings.Join(lines, "\n"))
+}
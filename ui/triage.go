@@ -0,0 +1,287 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/shurcooL/githubv4"
+	"github.com/skanehira/ght/domain"
+	"github.com/skanehira/ght/github"
+	"github.com/skanehira/ght/utils"
+)
+
+var triageCloseReasons = []string{"(no change)", "completed", "not planned"}
+
+// triageForm shows a form to change the labels, assignees and milestone of
+// the selected issues, comment on them and close them in one go.
+func triageForm() {
+	focus := func() {
+		UI.app.SetFocus(IssueUI)
+	}
+
+	issues, v, ok := selectedIssuesRepo(focus)
+	if !ok {
+		return
+	}
+
+	form := tview.NewForm()
+	form.SetBorder(true)
+	form.SetTitle(fmt.Sprintf("Triage %d issues", len(issues)))
+	form.SetTitleAlign(tview.AlignLeft)
+	labelWidth := 16
+
+	addLabelsInput := tview.NewInputField().SetLabel("Add labels").SetLabelWidth(labelWidth)
+	removeLabelsInput := tview.NewInputField().SetLabel("Remove labels").SetLabelWidth(labelWidth)
+	form.AddFormItem(addLabelsInput)
+	form.AddFormItem(removeLabelsInput)
+	// labelMap and userMap are set on the UI goroutine once loaded, and stay
+	// nil until then
+	var labelMap, userMap map[string]githubv4.ID
+	backToForm := func() {
+		UI.pages.SwitchToPage("triage").ShowPage("main")
+	}
+	go func() {
+		resp, err := github.GetRepoLabels(v)
+		UI.app.QueueUpdateDraw(func() {
+			if err != nil {
+				log.Println(err)
+				// the form may be closed by now
+				if UI.pages.HasPage("triage") {
+					UI.Message(fmt.Sprintf("failed to load labels: %s", err), backToForm)
+				}
+				return
+			}
+
+			labelMap = map[string]githubv4.ID{}
+			var labels []string
+			for _, l := range resp.Nodes {
+				name := string(l.Name)
+				labelMap[name] = l.ID
+				labels = append(labels, name)
+			}
+			complete := func(text string) []string {
+				return autocompleteFunc(text, labels)
+			}
+			addLabelsInput.SetAutocompleteFunc(complete)
+			removeLabelsInput.SetAutocompleteFunc(complete)
+		})
+	}()
+
+	assignInput := tview.NewInputField().SetLabel("Assign").SetLabelWidth(labelWidth)
+	unassignInput := tview.NewInputField().SetLabel("Unassign").SetLabelWidth(labelWidth)
+	form.AddFormItem(assignInput)
+	form.AddFormItem(unassignInput)
+	go func() {
+		resp, err := github.GetRepoAssignableUsers(v)
+		UI.app.QueueUpdateDraw(func() {
+			if err != nil {
+				log.Println(err)
+				// the form may be closed by now
+				if UI.pages.HasPage("triage") {
+					UI.Message(fmt.Sprintf("failed to load users: %s", err), backToForm)
+				}
+				return
+			}
+
+			userMap = map[string]githubv4.ID{}
+			var users []string
+			for _, u := range resp.Nodes {
+				name := string(u.Login)
+				userMap[name] = u.ID
+				users = append(users, name)
+			}
+			complete := func(text string) []string {
+				return autocompleteFunc(text, users)
+			}
+			assignInput.SetAutocompleteFunc(complete)
+			unassignInput.SetAutocompleteFunc(complete)
+		})
+	}()
+
+	milestoneDropDown := tview.NewDropDown().SetLabel("Milestone").SetLabelWidth(labelWidth).
+		SetOptions([]string{"(no change)"}, nil).SetCurrentOption(0)
+	form.AddFormItem(milestoneDropDown)
+	var milestoneID *githubv4.ID
+	go func() {
+		resp, err := github.GetRepoMillestones(v)
+		if err != nil {
+			log.Println(err)
+			return
+		}
+
+		milestones := map[string]*githubv4.ID{}
+		titles := []string{"(no change)"}
+		for _, milestone := range resp.Nodes {
+			id := milestone.ID
+			title := string(milestone.Title)
+			milestones[title] = &id
+			titles = append(titles, title)
+		}
+		UI.app.QueueUpdateDraw(func() {
+			milestoneDropDown.SetOptions(titles, func(text string, index int) {
+				milestoneID = milestones[text]
+			})
+			milestoneDropDown.SetCurrentOption(0)
+		})
+	}()
+
+	closeDropDown := tview.NewDropDown().SetLabel("Close").SetLabelWidth(labelWidth).
+		SetOptions(triageCloseReasons, nil).SetCurrentOption(0)
+	form.AddFormItem(closeDropDown)
+
+	closeForm := func() {
+		UI.pages.RemovePage("triage").ShowPage("main")
+		focus()
+	}
+
+	var comment string
+	form.AddButton("Edit Comment", func() {
+		UI.app.Suspend(func() {
+			if err := utils.Edit(&comment); err != nil {
+				log.Println(err)
+			}
+		})
+	})
+	form.AddButton("Apply", func() {
+		changes := &github.IssueChangeSet{
+			MilestoneID: milestoneID,
+			Comment:     strings.TrimSpace(comment),
+		}
+		var err error
+		if changes.AddLabelIDs, err = lookupNames(addLabelsInput.GetText(), labelMap, "label"); err != nil {
+			UI.Message(err.Error(), backToForm)
+			return
+		}
+		if changes.RemoveLabelIDs, err = lookupNames(removeLabelsInput.GetText(), labelMap, "label"); err != nil {
+			UI.Message(err.Error(), backToForm)
+			return
+		}
+		if changes.AddAssigneeIDs, err = lookupNames(assignInput.GetText(), userMap, "user"); err != nil {
+			UI.Message(err.Error(), backToForm)
+			return
+		}
+		if changes.RemoveAssigneeIDs, err = lookupNames(unassignInput.GetText(), userMap, "user"); err != nil {
+			UI.Message(err.Error(), backToForm)
+			return
+		}
+		switch i, _ := closeDropDown.GetCurrentOption(); i {
+		case 1:
+			changes.CloseReason = github.IssueClosedStateReasonCompleted
+		case 2:
+			changes.CloseReason = github.IssueClosedStateReasonNotPlanned
+		}
+
+		if changes.Empty() {
+			UI.Message("nothing to change", backToForm)
+			return
+		}
+
+		closeForm()
+		go applyTriage(issues, changes)
+	})
+	form.AddButton("Cancel", closeForm)
+
+	form.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEsc:
+			closeForm()
+			return nil
+		case tcell.KeyCtrlN:
+			k := tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone)
+			UI.app.QueueEvent(k)
+		case tcell.KeyCtrlP:
+			k := tcell.NewEventKey(tcell.KeyBacktab, 0, tcell.ModNone)
+			UI.app.QueueEvent(k)
+		}
+		return event
+	})
+
+	UI.pages.AddAndSwitchToPage("triage", UI.Modal(form, 80, 17), true).ShowPage("main")
+}
+
+// lookupNames returns the IDs of the comma separated names in text, or an
+// error naming the first name that is not in ids. ids is nil while the names
+// are still loading.
+func lookupNames(text string, ids map[string]githubv4.ID, kind string) ([]githubv4.ID, error) {
+	var result []githubv4.ID
+	for _, name := range strings.Split(text, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if ids == nil {
+			return nil, fmt.Errorf("the %ss are not loaded yet", kind)
+		}
+		id, ok := ids[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown %s: %s", kind, name)
+		}
+		result = append(result, id)
+	}
+	return result, nil
+}
+
+// applyTriage applies the changes to the issues, then shows how it went for
+// each issue and reloads the issue list.
+func applyTriage(issues []*domain.Issue, changes *github.IssueChangeSet) {
+	ids := make([]string, len(issues))
+	for i, issue := range issues {
+		ids[i] = issue.ID
+	}
+	errs := github.ApplyIssueChangeSet(context.Background(), ids, changes)
+
+	UI.app.QueueUpdateDraw(func() {
+		showTriageResults(issues, errs)
+	})
+
+	IssueUI.ClearSelected()
+	IssueUI.GetList()
+}
+
+// showTriageResults shows the result of a triage for each issue.
+func showTriageResults(issues []*domain.Issue, errs []error) {
+	table := tview.NewTable().SetSelectable(true, false).SetFixed(1, 0)
+
+	failed := 0
+	for i, h := range []string{"Number", "Title", "Result"} {
+		table.SetCell(0, i, &tview.TableCell{
+			Text:          h,
+			NotSelectable: true,
+			Color:         tcell.ColorWhite,
+			Attributes:    tcell.AttrBold | tcell.AttrUnderline,
+		})
+	}
+	for i, issue := range issues {
+		result, color := "ok", tcell.ColorGreen
+		if errs[i] != nil {
+			result, color = errs[i].Error(), tcell.ColorRed
+			failed++
+		}
+		row := i + 1
+		table.SetCell(row, 0, tview.NewTableCell("#"+issue.Number))
+		table.SetCell(row, 1, tview.NewTableCell(tview.Escape(issue.Title)).SetMaxWidth(40))
+		table.SetCell(row, 2, tview.NewTableCell(tview.Escape(result)).SetTextColor(color))
+	}
+
+	table.SetBorder(true).SetTitleAlign(tview.AlignLeft).
+		SetTitle(fmt.Sprintf("Triage: %d ok, %d failed (Esc/Enter: close)", len(issues)-failed, failed))
+
+	closeResults := func() {
+		UI.pages.RemovePage("triage-results").ShowPage("main")
+		UI.app.SetFocus(IssueUI)
+	}
+	table.SetSelectedFunc(func(row, column int) {
+		closeResults()
+	})
+	table.SetDoneFunc(func(key tcell.Key) {
+		if key == tcell.KeyEsc {
+			closeResults()
+		}
+	})
+
+	UI.pages.AddAndSwitchToPage("triage-results", UI.Modal(table, 100, 20), true).ShowPage("main")
+}
@@ -22,7 +22,31 @@ type ui struct {
 	primitives   []Primitive
 	primitiveLen int
 	updater      chan func()
-	activePage   string // tracks current page: "main" or "actions"
+	activePage   string // tracks current page: "main", "pulls", "diff", "checks" or "actions"
+	focusRings   map[string]*focusRing
+}
+
+// focusRing tracks the focusable primitives of a page other than "main"
+// and which of them currently has focus.
+type focusRing struct {
+	primitives []Primitive
+	current    int
+}
+
+func (r *focusRing) focusAt(index int) {
+	r.primitives[r.current].blur()
+	r.current = index
+	p := r.primitives[r.current]
+	p.focus()
+	UI.app.SetFocus(p)
+}
+
+func (r *focusRing) move(delta int) {
+	if !UI.canFocus() {
+		return
+	}
+	n := len(r.primitives)
+	r.focusAt((r.current + delta + n) % n)
 }
 
 func New() *ui {
@@ -79,6 +103,16 @@ func (ui *ui) toPrevUI() {
 	ui.app.SetFocus(p)
 }
 
+// switchToPage shows the page and focuses the primitive that had focus when
+// the page was left.
+func (ui *ui) switchToPage(name string) {
+	ui.pages.SwitchToPage(name)
+	ui.activePage = name
+	if r, ok := ui.focusRings[name]; ok {
+		r.focusAt(r.current)
+	}
+}
+
 func (ui *ui) Modal(p tview.Primitive, width, height int) tview.Primitive {
 	return tview.NewGrid().
 		SetColumns(0, width, 0).
@@ -99,6 +133,13 @@ func (ui *ui) Message(msg string, focusFunc func()) {
 }
 
 func (ui *ui) FullScreenPreview(contents string, focus func()) {
+	// coloring and keys are set by the previews that need them
+	CommonViewUI.colorize = nil
+	CommonViewUI.capture = nil
+	CommonViewUI.search = nil
+	CommonViewUI.showLine = nil
+	CommonViewUI.clearSearch()
+	CommonViewUI.SetTitle(string(UIKindCommonView))
 	CommonViewUI.SetText(contents).ScrollToBeginning()
 	CommonViewUI.setFocus = focus
 	CommonViewUI.returnPage = ui.activePage
@@ -108,11 +149,12 @@ func (ui *ui) FullScreenPreview(contents string, focus func()) {
 }
 
 func (ui *ui) Confirm(msg, doLabel string, doFunc func() error, focusFunc func()) {
+	activePage := ui.activePage
 	modal := tview.NewModal().
 		SetText(msg).
 		AddButtons([]string{doLabel, "Cancel"}).
 		SetDoneFunc(func(_ int, buttonLabel string) {
-			ui.pages.RemovePage("modal").ShowPage("main")
+			ui.pages.RemovePage("modal").ShowPage(activePage)
 			focusFunc()
 			if buttonLabel == doLabel {
 				if err := doFunc(); err != nil {
@@ -122,13 +164,23 @@ func (ui *ui) Confirm(msg, doLabel string, doFunc func() error, focusFunc func()
 				}
 			}
 		})
-	ui.pages.AddAndSwitchToPage("modal", ui.Modal(modal, 80, 29), true).ShowPage("main")
+	ui.pages.AddAndSwitchToPage("modal", ui.Modal(modal, 80, 29), true).ShowPage(activePage)
 }
 
 func (ui *ui) Start() error {
 	NewFilterUI()
+	NewPullRequestFilterUI()
+	NewRunFilterUI()
 	NewViewUI(UIKindIssueView)
 	NewViewUI(UIKindCommentView)
+	NewViewUI(UIKindPullRequestView)
+	NewViewUI(UIKindDiffView)
+	NewViewUI(UIKindStepLogView)
+	NewViewUI(UIKindArtifactView)
+	NewViewUI(UIKindActionsStatsView)
+	NewViewUI(UIKindWorkflowFileView)
+	NewViewUI(UIKindWorkflowOutline)
+	NewViewUI(UIKindTimelineView)
 	NewViewUI(UIKindCommonView)
 	NewIssueUI()
 	NewLabelsUI()
@@ -157,11 +209,26 @@ func (ui *ui) Start() error {
 		AddItem(CommentViewUI, row+5, col+4, rowSpan+4, colSpan+3, 0, 0, true).
 		AddItem(SearchUI, row+9, col, rowSpan+1, colSpan+7, 0, 0, true)
 
+	pullsGrid := NewPullRequestsUI()
+	diffGrid := NewPullRequestDiffUI()
+	checksGrid := NewStatusChecksUI()
 	actionsGrid := NewActionsUI()
+	timelineGrid := NewTimelineUI()
 
 	ui.pages = tview.NewPages().
 		AddAndSwitchToPage("main", grid, true).
-		AddPage("actions", actionsGrid, true, false)
+		AddPage("pulls", pullsGrid, true, false).
+		AddPage("diff", diffGrid, true, false).
+		AddPage("checks", checksGrid, true, false).
+		AddPage("actions", actionsGrid, true, false).
+		AddPage("timeline", timelineGrid, true, false)
+
+	ui.focusRings = map[string]*focusRing{
+		"pulls":    pullRequestFocus,
+		"diff":     diffFocus,
+		"checks":   checksFocus,
+		"timeline": timelineFocus,
+	}
 
 	ui.activePage = "main"
 
@@ -172,26 +239,40 @@ func (ui *ui) Start() error {
 		case tcell.KeyCtrlN:
 			if ui.activePage == "main" {
 				UI.toNextUI()
+			} else if r, ok := ui.focusRings[ui.activePage]; ok {
+				r.move(1)
 			}
 		case tcell.KeyCtrlP:
 			if ui.activePage == "main" {
 				UI.toPrevUI()
+			} else if r, ok := ui.focusRings[ui.activePage]; ok {
+				r.move(-1)
 			}
 		case tcell.KeyCtrlG:
-			if ui.activePage == "main" {
+			switch ui.activePage {
+			case "main":
 				ui.primitives[ui.current].blur()
 				ui.current = 5
 				p := ui.primitives[ui.current]
 				p.focus()
 				ui.app.SetFocus(IssueUI)
+			case "pulls":
+				pullRequestFocus.focusAt(1)
 			}
 		case tcell.KeyCtrlT:
-			if ui.activePage == "main" {
+			switch ui.activePage {
+			case "main":
 				ui.primitives[ui.current].blur()
 				ui.current = 0
 				p := ui.primitives[ui.current]
 				p.focus()
 				ui.app.SetFocus(IssueFilterUI)
+			case "pulls":
+				pullRequestFocus.focusAt(0)
+			}
+		case tcell.KeyCtrlR:
+			if ui.activePage != "pulls" && ui.canFocus() {
+				ui.switchToPage("pulls")
 			}
 		case tcell.KeyCtrlA:
 			if ui.activePage != "actions" {
@@ -201,7 +282,11 @@ func (ui *ui) Start() error {
 				ui.app.SetFocus(WorkflowRunsUI)
 			}
 		case tcell.KeyCtrlI:
-			if ui.activePage == "actions" {
+			// terminals send Tab as Ctrl-I, so the filter inputs keep it
+			if _, ok := ui.app.GetFocus().(*FilterUI); ok {
+				break
+			}
+			if (ui.activePage == "actions" || ui.activePage == "pulls") && ui.canFocus() {
 				ui.pages.SwitchToPage("main")
 				ui.activePage = "main"
 				p := ui.primitives[ui.current]
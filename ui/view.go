@@ -1,7 +1,9 @@
 package ui
 
 import (
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
@@ -9,9 +11,17 @@ import (
 )
 
 var (
-	IssueViewUI   *ViewUI
-	CommentViewUI *ViewUI
-	CommonViewUI  *ViewUI
+	IssueViewUI        *ViewUI
+	CommentViewUI      *ViewUI
+	PullRequestViewUI  *ViewUI
+	DiffViewUI         *ViewUI
+	StepLogViewUI      *ViewUI
+	ArtifactViewUI     *ViewUI
+	ActionsStatsViewUI *ViewUI
+	WorkflowFileViewUI *ViewUI
+	WorkflowOutlineUI  *ViewUI
+	TimelineViewUI     *ViewUI
+	CommonViewUI       *ViewUI
 )
 
 type ViewUI struct {
@@ -22,6 +32,17 @@ type ViewUI struct {
 	uiKind       UIKind
 	setFocus     func()
 	returnPage   string // page to return to when closing full-screen preview
+	colorize     func(text string) string
+	search       func(input string) // replaces the search of views showing a part of their content
+	capture      CaptureFunc
+
+	// showLine highlights a line of views whose search finds whole lines.
+	// n and N then jump between the searchHits found for searchQuery,
+	// searchIndex being the hit that was jumped to last.
+	showLine    func(line int)
+	searchQuery string
+	searchHits  []int
+	searchIndex int
 }
 
 func NewViewUI(uiKind UIKind) {
@@ -47,6 +68,46 @@ func NewViewUI(uiKind UIKind) {
 		setFocus = func() {
 			UI.app.SetFocus(CommentViewUI)
 		}
+	case UIKindPullRequestView:
+		PullRequestViewUI = ui
+		setFocus = func() {
+			UI.app.SetFocus(PullRequestViewUI)
+		}
+	case UIKindDiffView:
+		DiffViewUI = ui
+		setFocus = func() {
+			UI.app.SetFocus(DiffViewUI)
+		}
+	case UIKindStepLogView:
+		StepLogViewUI = ui
+		setFocus = func() {
+			UI.app.SetFocus(StepLogViewUI)
+		}
+	case UIKindArtifactView:
+		ArtifactViewUI = ui
+		setFocus = func() {
+			UI.app.SetFocus(ArtifactViewUI)
+		}
+	case UIKindActionsStatsView:
+		ActionsStatsViewUI = ui
+		setFocus = func() {
+			UI.app.SetFocus(ActionsStatsViewUI)
+		}
+	case UIKindWorkflowFileView:
+		WorkflowFileViewUI = ui
+		setFocus = func() {
+			UI.app.SetFocus(WorkflowFileViewUI)
+		}
+	case UIKindWorkflowOutline:
+		WorkflowOutlineUI = ui
+		setFocus = func() {
+			UI.app.SetFocus(WorkflowOutlineUI)
+		}
+	case UIKindTimelineView:
+		TimelineViewUI = ui
+		setFocus = func() {
+			UI.app.SetFocus(TimelineViewUI)
+		}
 	case UIKindCommonView:
 		CommonViewUI = ui
 	}
@@ -62,6 +123,11 @@ func NewViewUI(uiKind UIKind) {
 			}
 		}
 
+		// searching strips the colors, so views with colored text restore them
+		if ui.colorize != nil {
+			text = ui.colorize(text)
+		}
+
 		go ui.updateView(text)
 	}
 
@@ -69,18 +135,26 @@ func NewViewUI(uiKind UIKind) {
 		switch event.Rune() {
 		case '/':
 			SearchUI.SetText("")
-			SearchUI.SetSerachFunc(searchFunc)
+			if ui.search != nil {
+				SearchUI.SetSerachFunc(ui.search)
+			} else {
+				SearchUI.SetSerachFunc(searchFunc)
+			}
 			SearchUI.SetFocusFunc(func() {
 				UI.app.SetFocus(ui)
 			})
 			UI.app.SetFocus(SearchUI)
 		case 'n':
-			if ui.regionLength > 0 {
+			if ui.showLine != nil {
+				ui.jumpToSearchHit(1)
+			} else if ui.search == nil && ui.regionLength > 0 {
 				ui.regionIndex = (ui.regionIndex + 1) % ui.regionLength
 				ui.Highlight(strconv.Itoa(ui.regionIndex)).ScrollToHighlight()
 			}
 		case 'N':
-			if ui.regionLength > 0 {
+			if ui.showLine != nil {
+				ui.jumpToSearchHit(-1)
+			} else if ui.search == nil && ui.regionLength > 0 {
 				ui.regionIndex = (ui.regionIndex - 1 + ui.regionLength) % ui.regionLength
 				ui.Highlight(strconv.Itoa(ui.regionIndex)).ScrollToHighlight()
 			}
@@ -99,6 +173,9 @@ func NewViewUI(uiKind UIKind) {
 
 		//switch event.Key() {
 		//}
+		if ui.capture != nil {
+			return ui.capture(event)
+		}
 		return event
 	})
 
@@ -115,6 +192,54 @@ func (ui *ViewUI) updateView(text string) {
 	}
 }
 
+// searchLines searches lines for query, shows how many lines were found after
+// title and jumps to the first of them.
+func (ui *ViewUI) searchLines(query string, lines []string, title string) {
+	var hits []int
+	if query != "" {
+		for i, line := range lines {
+			if strings.Contains(line, query) {
+				hits = append(hits, i)
+			}
+		}
+	}
+	ui.setSearchHits(query, hits)
+	ui.SetTitle(ui.searchTitle(title))
+	ui.jumpToSearchHit(1)
+}
+
+// setSearchHits sets the lines found for query, for views that search lines
+// they do not show all at once.
+func (ui *ViewUI) setSearchHits(query string, hits []int) {
+	ui.searchQuery = query
+	ui.searchHits = hits
+	ui.searchIndex = -1
+}
+
+// clearSearch forgets the lines found by the last search.
+func (ui *ViewUI) clearSearch() {
+	ui.setSearchHits("", nil)
+}
+
+// searchTitle returns title with the last search and how many lines it found.
+func (ui *ViewUI) searchTitle(title string) string {
+	if ui.searchQuery == "" {
+		return title
+	}
+	return fmt.Sprintf("%s | %s: %d matches", title, tview.Escape(fmt.Sprintf("%q", ui.searchQuery)), len(ui.searchHits))
+}
+
+// jumpToSearchHit shows the next (delta 1) or previous (delta -1) line found
+// by the last search.
+func (ui *ViewUI) jumpToSearchHit(delta int) {
+	n := len(ui.searchHits)
+	if n == 0 || ui.showLine == nil {
+		return
+	}
+	ui.searchIndex = (ui.searchIndex + delta + n) % n
+	ui.showLine(ui.searchHits[ui.searchIndex])
+}
+
 func (v *ViewUI) focus() {}
 
 func (v *ViewUI) blur() {}
@@ -0,0 +1,263 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	gogithub "github.com/google/go-github/v68/github"
+	"github.com/rivo/tview"
+	"github.com/skanehira/ght/config"
+	"github.com/skanehira/ght/domain"
+	"github.com/skanehira/ght/github"
+)
+
+var (
+	// workflowFileName is the workflow whose file is shown, workflowFilePath
+	// its path and workflowFileLines its lines, workflowWarnings the problems
+	// found in it and workflowWarningIndex the warning that was jumped to last.
+	workflowFileName     string
+	workflowFilePath     string
+	workflowFileLines    []string
+	workflowWarnings     []*domain.WorkflowWarning
+	workflowWarningIndex int
+
+	// yamlKeyRegex splits a line of YAML into its indentation and sequence
+	// dashes, a key, and the value after the key.
+	yamlKeyRegex = regexp.MustCompile(`^(\s*(?:-\s+)*)("[^"]*"|'[^']*'|[^\s#'"\[\]{}][^#]*?):(\s+|$)(.*)$`)
+
+	// yamlItemRegex splits a sequence item without a key into its
+	// indentation and dashes, and its value.
+	yamlItemRegex = regexp.MustCompile(`^(\s*(?:-\s+)+)(.*)$`)
+
+	yamlLiteralRegex    = regexp.MustCompile(`^(true|false|null|~|-?[0-9][0-9_.]*)$`)
+	yamlBlockRegex      = regexp.MustCompile(`^[|>][-+0-9]*$`)
+	workflowExprRegex   = regexp.MustCompile(`\$\{\{.*?\}\}`)
+	workflowIndentRegex = regexp.MustCompile(`^\s*`)
+)
+
+// newWorkflowFileUI creates the view with a workflow file next to the outline
+// of its triggers, jobs and the problems found in it.
+func newWorkflowFileUI() tview.Primitive {
+	WorkflowFileViewUI.search = searchWorkflowFile
+	WorkflowFileViewUI.showLine = showWorkflowLine
+	WorkflowFileViewUI.capture = func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEscape:
+			switchToRunsView()
+			return nil
+		}
+		switch event.Rune() {
+		case ']':
+			jumpToWorkflowWarning(1)
+		case '[':
+			jumpToWorkflowWarning(-1)
+		}
+		return event
+	}
+
+	return tview.NewFlex().
+		AddItem(WorkflowFileViewUI, 0, 2, true).
+		AddItem(WorkflowOutlineUI, 0, 1, false)
+}
+
+// openWorkflowFile switches to the workflow file view and shows the file of
+// workflow at the default branch.
+func openWorkflowFile(workflow *gogithub.Workflow) {
+	workflowFileName = workflow.GetName()
+	workflowFilePath = workflow.GetPath()
+	workflowFileLines = nil
+	workflowWarnings = nil
+	WorkflowFileViewUI.clearSearch()
+
+	WorkflowFileViewUI.SetTitle(workflowFilePath)
+	WorkflowFileViewUI.SetText("Loading...")
+	WorkflowOutlineUI.SetText("")
+
+	actionsPages.SwitchToPage("workflow-view")
+	WorkflowRunsUI.blur()
+	UI.app.SetFocus(WorkflowFileViewUI)
+	updateActionsStatusLine()
+
+	go func() {
+		content, err := github.GetWorkflowFile(context.Background(), config.GitHub.Owner, config.GitHub.Repo, workflow.GetPath(), "")
+		if err != nil {
+			UI.updater <- func() {
+				WorkflowFileViewUI.SetText(tview.Escape(err.Error()))
+			}
+			return
+		}
+
+		// the file is still worth reading when it cannot be parsed
+		definition, parseErr := github.ParseWorkflowDefinition([]byte(content))
+
+		UI.app.QueueUpdateDraw(func() {
+			workflowFileLines = strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+			workflowWarningIndex = -1
+			if definition != nil {
+				workflowWarnings = definition.Warnings
+			}
+			WorkflowFileViewUI.SetText(colorizeYAML(workflowFileLines)).ScrollToBeginning()
+			WorkflowOutlineUI.SetText(renderWorkflowOutline(definition, parseErr)).ScrollToBeginning()
+		})
+	}()
+}
+
+// renderWorkflowOutline renders the triggers and jobs of a workflow and the
+// problems found in it.
+func renderWorkflowOutline(definition *domain.WorkflowDefinition, err error) string {
+	if err != nil {
+		return "[red]" + tview.Escape(err.Error()) + "[white]"
+	}
+
+	var b strings.Builder
+	b.WriteString("[yellow::b]Triggers[-:-:-]\n")
+	for _, trigger := range definition.Triggers {
+		fmt.Fprintf(&b, "  %s\n", tview.Escape(trigger))
+	}
+
+	b.WriteString("\n[yellow::b]Jobs[-:-:-]\n")
+	for _, job := range definition.Jobs {
+		fmt.Fprintf(&b, "  [darkcyan]%s[white]", tview.Escape(job.ID))
+		if job.Name != "" {
+			fmt.Fprintf(&b, " (%s)", tview.Escape(job.Name))
+		}
+		b.WriteString("\n")
+		if job.RunsOn != "" {
+			fmt.Fprintf(&b, "    runs-on: %s\n", tview.Escape(job.RunsOn))
+		}
+		if job.Uses != "" {
+			fmt.Fprintf(&b, "    uses: %s\n", tview.Escape(job.Uses))
+		}
+		if len(job.Needs) > 0 {
+			fmt.Fprintf(&b, "    needs: %s\n", tview.Escape(strings.Join(job.Needs, ", ")))
+		}
+	}
+
+	fmt.Fprintf(&b, "\n[yellow::b]Warnings (%d)[-:-:-]\n", len(definition.Warnings))
+	if len(definition.Warnings) == 0 {
+		b.WriteString("  No problems found.\n")
+	}
+	for _, w := range definition.Warnings {
+		fmt.Fprintf(&b, "  [orange]%s[white]\n", tview.Escape(w.String()))
+	}
+	return b.String()
+}
+
+// colorizeYAML colors the keys, values, comments and expressions of the lines
+// of a workflow file and wraps every line in a region so it can be jumped to.
+func colorizeYAML(lines []string) string {
+	colored := make([]string, len(lines))
+
+	// the indentation of the key of a block scalar whose lines are being
+	// colored, or -1 outside of block scalars
+	blockIndent := -1
+
+	for i, line := range lines {
+		indent := len(workflowIndentRegex.FindString(line))
+		if blockIndent >= 0 && (strings.TrimSpace(line) == "" || indent > blockIndent) {
+			colored[i] = fmt.Sprintf(`["%s"]%s[""]`, workflowLineRegion(i), colorizeYAMLValue(line, "white"))
+			continue
+		}
+		blockIndent = -1
+
+		code, comment := splitYAMLComment(line)
+		var text string
+		if m := yamlKeyRegex.FindStringSubmatch(code); m != nil {
+			text = colorizeYAMLDashes(m[1]) + "[darkcyan]" + tview.Escape(m[2]) + "[white]:" + m[3] + colorizeYAMLScalar(m[4])
+			if yamlBlockRegex.MatchString(strings.TrimSpace(m[4])) {
+				blockIndent = indent
+			}
+		} else if m := yamlItemRegex.FindStringSubmatch(code); m != nil {
+			text = colorizeYAMLDashes(m[1]) + colorizeYAMLScalar(m[2])
+		} else {
+			text = colorizeYAMLValue(code, "white")
+		}
+		if comment != "" {
+			text += "[gray]" + tview.Escape(comment) + "[white]"
+		}
+		colored[i] = fmt.Sprintf(`["%s"]%s[""]`, workflowLineRegion(i), text)
+	}
+	return strings.Join(colored, "\n")
+}
+
+// colorizeYAMLDashes colors the dashes of sequence items in the indentation of a line.
+func colorizeYAMLDashes(prefix string) string {
+	return strings.ReplaceAll(prefix, "-", "[yellow]-[white]")
+}
+
+// colorizeYAMLScalar colors a value after a key or dash by its type.
+func colorizeYAMLScalar(value string) string {
+	trimmed := strings.TrimSpace(value)
+	switch {
+	case trimmed == "":
+		return value
+	case yamlBlockRegex.MatchString(trimmed):
+		return "[yellow]" + tview.Escape(value) + "[white]"
+	case yamlLiteralRegex.MatchString(trimmed):
+		return "[purple]" + tview.Escape(value) + "[white]"
+	}
+	return colorizeYAMLValue(value, "green")
+}
+
+// colorizeYAMLValue colors text, highlighting the expressions in it.
+func colorizeYAMLValue(text, color string) string {
+	var b strings.Builder
+	last := 0
+	for _, loc := range workflowExprRegex.FindAllStringIndex(text, -1) {
+		fmt.Fprintf(&b, "[%s]%s[orange]%s", color, tview.Escape(text[last:loc[0]]), tview.Escape(text[loc[0]:loc[1]]))
+		last = loc[1]
+	}
+	fmt.Fprintf(&b, "[%s]%s[white]", color, tview.Escape(text[last:]))
+	return b.String()
+}
+
+// splitYAMLComment splits a line into its code and the comment at its end,
+// ignoring # inside of quotes and # that are not preceded by a space.
+func splitYAMLComment(line string) (code, comment string) {
+	var quote rune
+	for i, r := range line {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			quote = r
+		case r == '#' && (i == 0 || line[i-1] == ' ' || line[i-1] == '\t'):
+			return line[:i], line[i:]
+		}
+	}
+	return line, ""
+}
+
+func workflowLineRegion(index int) string {
+	return fmt.Sprintf("wf%d", index)
+}
+
+// showWorkflowLine highlights a line of the workflow file.
+func showWorkflowLine(index int) {
+	WorkflowFileViewUI.Highlight(workflowLineRegion(index)).ScrollToHighlight()
+}
+
+// jumpToWorkflowWarning highlights the line of the next (delta 1) or previous
+// (delta -1) problem found in the workflow file.
+func jumpToWorkflowWarning(delta int) {
+	n := len(workflowWarnings)
+	if n == 0 {
+		return
+	}
+	workflowWarningIndex = (workflowWarningIndex + delta + n) % n
+	w := workflowWarnings[workflowWarningIndex]
+	if w.Line > 0 {
+		showWorkflowLine(w.Line - 1)
+	}
+	WorkflowFileViewUI.SetTitle(fmt.Sprintf("%s | %s", workflowFilePath, tview.Escape(w.String())))
+}
+
+// searchWorkflowFile jumps to the first line of the workflow file containing query.
+func searchWorkflowFile(query string) {
+	WorkflowFileViewUI.searchLines(query, workflowFileLines, workflowFilePath)
+}